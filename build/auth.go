@@ -0,0 +1,212 @@
+package build
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/http"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"otusgruz/internal/oidc"
+	"otusgruz/internal/oidc/oidctest"
+	query "otusgruz/internal/repo"
+	"otusgruz/internal/restapi"
+	"otusgruz/internal/service/api/auth"
+)
+
+const (
+	edgeAuthEndpoint = "/verify"
+	edgeLiveEndpoint = "/health/live"
+)
+
+var (
+	ErrJWTSecretMissing      = errors.New("AUTH_JWT_SECRET is not set")
+	ErrUnknownSessionMode    = errors.New("unknown session mode")
+	ErrUnknownCookieSameSite = errors.New("unknown cookie SameSite mode")
+	ErrInsecureSameSiteNone  = errors.New("AUTH_COOKIE_SAMESITE=none requires AUTH_COOKIE_SECURE=true")
+	ErrInvalidOIDCProvider   = errors.New("invalid oidc provider name")
+	ErrTrustedProxyCIDRs     = errors.New("AUTH_TRUSTED_PROXY=true requires AUTH_TRUSTED_PROXY_CIDRS")
+)
+
+// oidcProviderName имя провайдера становится сегментом пути /auth/oidc/{provider} и частью имен переменных окружения.
+var oidcProviderName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+func (b *Builder) AuthService() (auth.Service, error) {
+	psql, err := b.PostgresClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating postgres client")
+	}
+
+	return b.authService(psql.DB, b.NewRepo(psql.DB))
+}
+
+func (b *Builder) authService(db *sql.DB, q *query.Queries) (auth.Service, error) {
+	mail, err := b.mailer()
+	if err != nil {
+		return nil, err
+	}
+
+	providers, err := b.oidcProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	srv, err := auth.NewService(auth.NewRepo(db, q), mail, auth.Config{
+		Secret:           b.config.Auth.JWTSecret,
+		Issuer:           b.config.Auth.JWTIssuer,
+		AccessTTL:        b.config.Auth.AccessTTL,
+		RefreshTTL:       b.config.Auth.RefreshTTL,
+		BcryptCost:       b.config.Auth.BcryptCost,
+		SessionCheckTTL:  b.config.Auth.SessionCheckTTL,
+		VerifyEmailTTL:   b.config.Auth.VerifyEmailTTL,
+		PasswordResetTTL: b.config.Auth.PasswordResetTTL,
+		LinkBaseURL:      b.config.Mail.LinkBaseURL,
+		OIDC:             providers,
+		OIDCStateTTL:     b.config.OIDC.StateTTL,
+		MFAIssuer:        b.config.Auth.MFAIssuer,
+		MFAChallengeTTL:  b.config.Auth.MFAChallengeTTL,
+		MFAMaxAttempts:   b.config.Auth.MFAMaxAttempts,
+		MFALockout:       b.config.Auth.MFALockout,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "auth service")
+	}
+
+	return srv, nil
+}
+
+func (b *Builder) oidcProviders() (map[string]auth.OIDCProvider, error) {
+	client := &http.Client{Timeout: b.config.OIDC.HTTPTimeout} //nolint:exhaustruct
+	res := make(map[string]auth.OIDCProvider, len(b.config.OIDC.Providers))
+
+	for _, name := range b.config.OIDC.Providers {
+		if !oidcProviderName.MatchString(name) {
+			return nil, errors.Wrapf(ErrInvalidOIDCProvider, "%q", name)
+		}
+
+		conf := b.config.OIDC.ProviderConfigs[name]
+
+		res[name] = auth.OIDCProvider{
+			Client: oidc.NewProvider(client, oidc.Config{
+				Issuer:       conf.Issuer,
+				ClientID:     conf.ClientID,
+				ClientSecret: conf.ClientSecret,
+				RedirectURL:  conf.RedirectURL,
+				Scopes:       conf.Scopes,
+				JWKSCacheTTL: b.config.OIDC.JWKSCacheTTL,
+			}),
+			LinkByEmail: conf.LinkByEmail,
+		}
+	}
+
+	return res, nil
+}
+
+// FakeIDPServer HTTP сервер с OIDC провайдером в памяти для локальной разработки, см. oidctest.
+func (b *Builder) FakeIDPServer(ctx context.Context) (*http.Server, error) {
+	server, err := b.HTTPServer(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating http server")
+	}
+
+	provider, err := oidctest.New(b.config.OIDC.FakeIssuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating fake oidc provider")
+	}
+
+	b.httpRouter().PathPrefix("/").Handler(provider)
+
+	return server, nil
+}
+
+func (b *Builder) trustedProxy() (restapi.TrustedProxy, error) {
+	res := restapi.TrustedProxy{Enabled: b.config.Auth.TrustedProxy} //nolint:exhaustruct
+
+	for _, cidr := range b.config.Auth.TrustedProxyCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return res, errors.Wrapf(err, "parse trusted proxy cidr %q", cidr)
+		}
+
+		res.Networks = append(res.Networks, network)
+	}
+
+	if res.Enabled && len(res.Networks) == 0 {
+		return res, ErrTrustedProxyCIDRs
+	}
+
+	return res, nil
+}
+
+// cookieSession настройки режима cookie, basePath - базовый путь API из спецификации.
+func (b *Builder) cookieSession(basePath string) (restapi.CookieSession, error) {
+	res := restapi.CookieSession{ //nolint:exhaustruct
+		Secure:     b.config.Auth.CookieSecure,
+		Domain:     b.config.Auth.CookieDomain,
+		Path:       basePath,
+		AccessTTL:  b.config.Auth.AccessTTL,
+		RefreshTTL: b.config.Auth.RefreshTTL,
+	}
+
+	switch b.config.Auth.SessionMode {
+	case "bearer":
+		return res, nil
+	case "cookie":
+		res.Enabled = true
+	default:
+		return res, errors.Wrapf(ErrUnknownSessionMode, "%q", b.config.Auth.SessionMode)
+	}
+
+	switch b.config.Auth.CookieSameSite {
+	case "strict":
+		res.SameSite = http.SameSiteStrictMode
+	case "lax":
+		res.SameSite = http.SameSiteLaxMode
+	case "none":
+		if !res.Secure {
+			return res, ErrInsecureSameSiteNone
+		}
+
+		res.SameSite = http.SameSiteNoneMode
+	default:
+		return res, errors.Wrapf(ErrUnknownCookieSameSite, "%q", b.config.Auth.CookieSameSite)
+	}
+
+	return res, nil
+}
+
+// EdgeAuthServer HTTP сервер для nginx auth-request. Проверяет только подпись и срок access token
+// из заголовка Authorization или, в режиме cookie, из cookie сессии, поэтому не требует подключения к базе.
+// Токен завершенной сессии здесь действует до истечения AUTH_ACCESS_TOKEN_TTL.
+func (b *Builder) EdgeAuthServer(ctx context.Context) (*http.Server, error) {
+	if b.config.Auth.JWTSecret == "" {
+		return nil, ErrJWTSecretMissing
+	}
+
+	server, err := b.HTTPServer(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating http server")
+	}
+
+	authn := auth.NewAuthenticator(auth.Config{ //nolint:exhaustruct
+		Secret:    b.config.Auth.JWTSecret,
+		Issuer:    b.config.Auth.JWTIssuer,
+		AccessTTL: b.config.Auth.AccessTTL,
+	})
+
+	// путь cookie нужен только для их выдачи, edge-аутентификация cookie лишь читает
+	cookies, err := b.cookieSession("")
+	if err != nil {
+		return nil, err
+	}
+
+	router := b.httpRouter()
+	router.Handle(edgeAuthEndpoint, restapi.NewEdgeAuthHandler(authn, cookies))
+	router.HandleFunc(edgeLiveEndpoint, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return server, nil
+}
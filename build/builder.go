@@ -3,6 +3,7 @@ package build
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 
 	"otusgruz/config"
 
@@ -13,13 +14,16 @@ import (
 type Builder struct {
 	config config.Config
 
-	shutdown shutdown
+	shutdown     shutdown
+	shuttingDown atomic.Bool
 
 	prometheusRegistry *prometheus.Registry
 
 	http struct {
 		router *mux.Router
 		server *http.Server
+		// serving сервер начал принимать соединения, без этого при остановке нечего ждать
+		serving atomic.Bool
 	}
 }
 
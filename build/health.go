@@ -0,0 +1,25 @@
+package build
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"otusgruz/internal/migration"
+	"otusgruz/internal/service/api/health"
+)
+
+func (b *Builder) healthService(db *sql.DB) (health.Service, error) {
+	version, err := migration.LatestVersion(migration.PostgresPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "latest embedded migration")
+	}
+
+	return health.NewService(
+		b.config.Health.CheckTimeout,
+		b.config.Health.CacheTTL,
+		health.NewShutdownChecker(b.ShuttingDown),
+		health.NewPostgresChecker(db),
+		health.NewMigrationChecker(db, version),
+	), nil
+}
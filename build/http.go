@@ -24,11 +24,16 @@ func (b *Builder) HTTPServer(ctx context.Context) (*http.Server, error) {
 		ReadHeaderTimeout: timeout,
 		Handler:           router,
 		ErrorLog:          log.New(zerolog.Nop(), "", 0),
+		// BaseContext вызывается в Serve, то есть только после успешного Listen
 		BaseContext: func(net.Listener) context.Context {
+			b.http.serving.Store(true)
+
 			return ctx
 		},
 	}
 
+	b.http.server = &server
+
 	return &server, nil
 }
 
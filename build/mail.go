@@ -0,0 +1,26 @@
+package build
+
+import (
+	"github.com/pkg/errors"
+
+	"otusgruz/internal/mailer"
+)
+
+var ErrUnknownMailTransport = errors.New("unknown mail transport")
+
+func (b *Builder) mailer() (mailer.Mailer, error) {
+	switch b.config.Mail.Transport {
+	case "smtp":
+		return mailer.NewSMTP(mailer.SMTPOptions{
+			Addr:     b.config.Mail.SMTPAddr,
+			Username: b.config.Mail.SMTPUsername,
+			Password: b.config.Mail.SMTPPassword,
+			From:     b.config.Mail.From,
+			Timeout:  b.config.Mail.SMTPTimeout,
+		}), nil
+	case "file":
+		return mailer.NewDir(b.config.Mail.Dir, b.config.Mail.From), nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownMailTransport, "%q", b.config.Mail.Transport)
+	}
+}
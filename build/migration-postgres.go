@@ -1,15 +1,27 @@
 package build
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"otusgruz/internal/migration"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // driver
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+var (
+	ErrMigrationDirty = errors.New("database schema is dirty")
+	ErrMigrationAhead = errors.New("database schema is ahead of embedded migrations")
 )
 
-func (b *Builder) PostgresMigration() (*migrate.Migrate, error) {
+// PostgresMigration драйвер postgres берет pg_advisory_lock на каждую операцию, поэтому
+// параллельные запуски из job и реплик выполняются по очереди, а ожидание ограничено конфигом.
+func (b *Builder) PostgresMigration(ctx context.Context) (*migrate.Migrate, error) {
 	d, err := iofs.New(migration.FS, migration.PostgresPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "embed postgres migrations")
@@ -20,5 +32,68 @@ func (b *Builder) PostgresMigration() (*migrate.Migrate, error) {
 		return nil, errors.Wrap(err, "apply postgres migrations")
 	}
 
+	m.LockTimeout = b.config.Postgres.MigrationLockTimeout
+	m.Log = migrationLogger{logger: zerolog.Ctx(ctx)}
+
 	return m, nil
 }
+
+// MigratePostgres применяет недостающие миграции. Грязная схема или схема новее бинаря считаются ошибкой.
+// Версию схемы проверяет Up под advisory lock, а после ошибки она перечитывается: проверка до Up
+// не защищала бы от параллельного запуска, который испортил или обновил схему между проверкой и Up.
+func (b *Builder) MigratePostgres(ctx context.Context) error {
+	m, err := b.PostgresMigration(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer m.Close()
+
+	latest, err := migration.LatestVersion(migration.PostgresPath)
+	if err != nil {
+		return errors.Wrap(err, "latest embedded migration")
+	}
+
+	if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return upError(m, err, latest)
+	}
+
+	zerolog.Ctx(ctx).Info().Uint("version", latest).Msg("postgres schema is up to date")
+
+	return nil
+}
+
+// upError переводит ошибку Up в ErrMigrationDirty или ErrMigrationAhead по состоянию схемы после нее.
+// Схему новее бинаря Up не распознает: он не находит ее версию среди встроенных миграций.
+func upError(m *migrate.Migrate, err error, latest uint) error {
+	var dirtyErr migrate.ErrDirty
+	if errors.As(err, &dirtyErr) {
+		return errors.Wrapf(ErrMigrationDirty, "version %d", dirtyErr.Version)
+	}
+
+	current, dirty, versionErr := m.Version()
+
+	switch {
+	case versionErr != nil:
+		return errors.Wrap(err, "up migrations")
+	case dirty:
+		// миграция этого или параллельного запуска упала на середине
+		return errors.Wrapf(ErrMigrationDirty, "version %d: %v", current, err)
+	case current > latest:
+		return errors.Wrapf(ErrMigrationAhead, "applied %d, embedded %d", current, latest)
+	default:
+		return errors.Wrap(err, "up migrations")
+	}
+}
+
+type migrationLogger struct {
+	logger *zerolog.Logger
+}
+
+func (l migrationLogger) Printf(format string, v ...interface{}) {
+	l.logger.Info().Str("component", "migrate").Msg(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (l migrationLogger) Verbose() bool {
+	return true
+}
@@ -0,0 +1,71 @@
+package build
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"otusgruz/internal/outbox"
+)
+
+var ErrUnknownPublisher = errors.New("unknown outbox publisher")
+
+func (b *Builder) OutboxRelay() (*outbox.Relay, error) {
+	psql, err := b.PostgresClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating postgres client")
+	}
+
+	publisher, err := b.outboxPublisher()
+	if err != nil {
+		return nil, err
+	}
+
+	return outbox.NewRelay(
+		outbox.NewRepo(psql.DB, b.NewRepo(psql.DB)),
+		publisher,
+		b.config.Outbox.BatchSize,
+		b.config.Outbox.PollInterval,
+	), nil
+}
+
+func (b *Builder) outboxPublisher() (outbox.Publisher, error) {
+	var publisher outbox.Publisher
+
+	switch b.config.Outbox.Publisher {
+	case "stdout":
+		publisher = outbox.NewWriterPublisher(os.Stdout)
+	case "file":
+		file, err := outbox.NewFilePublisher(b.config.Outbox.File)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating file publisher")
+		}
+
+		publisher = file
+	case "kafka":
+		kafka, err := outbox.NewKafkaPublisher(outbox.KafkaOptions{
+			Brokers:        b.config.Kafka.Brokers,
+			ClientID:       b.config.Kafka.ClientID,
+			Topic:          b.config.Kafka.Topic,
+			Acks:           b.config.Kafka.Acks,
+			Linger:         b.config.Kafka.Linger,
+			BatchMaxBytes:  b.config.Kafka.BatchMaxBytes,
+			Retries:        b.config.Kafka.Retries,
+			ProduceTimeout: b.config.Kafka.ProduceTimeout,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "creating kafka publisher")
+		}
+
+		publisher = kafka
+	default:
+		return nil, errors.Wrapf(ErrUnknownPublisher, "%q", b.config.Outbox.Publisher)
+	}
+
+	b.shutdown.add("outbox publisher", func(_ context.Context) error {
+		return errors.Wrap(publisher.Close(), "close outbox publisher")
+	})
+
+	return publisher, nil
+}
@@ -23,7 +23,7 @@ func (b *Builder) postgresClient(dsn string) (*sqlx.DB, error) {
 	db.SetMaxIdleConns(b.config.Postgres.MaxIdleConns)
 	db.SetConnMaxLifetime(b.config.Postgres.ConnMaxLifetime)
 
-	b.shutdown.add(func(_ context.Context) error {
+	b.shutdown.add("postgres", func(_ context.Context) error {
 		if err = db.Close(); err != nil {
 			return errors.Wrap(err, "close db connection")
 		}
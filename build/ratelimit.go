@@ -0,0 +1,85 @@
+package build
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/loads"
+	"github.com/pkg/errors"
+	promGo "github.com/prometheus/client_golang/prometheus"
+
+	"otusgruz/internal/ratelimit"
+	query "otusgruz/internal/repo"
+	"otusgruz/internal/restapi"
+	"otusgruz/internal/service/api/auth"
+)
+
+var (
+	ErrUnknownRateLimitBackend   = errors.New("unknown rate limit backend")
+	ErrUnknownRateLimitOperation = errors.New("unknown rate limit operation")
+)
+
+// rateLimiter middleware ограничения частоты запросов. Операции из RATE_LIMIT_OPERATIONS и RATE_LIMIT_EXEMPT
+// сверяются со спецификацией, чтобы опечатка в пути не отключала лимит незаметно.
+func (b *Builder) rateLimiter(
+	spec *loads.Document,
+	q *query.Queries,
+	authn auth.Authenticator,
+	trusted restapi.TrustedProxy,
+	cookies restapi.CookieSession,
+) (func(next http.Handler) http.Handler, error) {
+	if !b.config.RateLimit.Enabled {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+
+	conf := restapi.RateLimit{ //nolint:exhaustruct
+		Operations: make(map[string]ratelimit.Limit, len(b.config.RateLimit.Operations)),
+		Exempt:     b.config.RateLimit.Exempt,
+	}
+
+	switch b.config.RateLimit.Backend {
+	case "memory":
+		conf.Store = ratelimit.NewMemory()
+	case "postgres":
+		conf.Store = ratelimit.NewPostgres(q)
+	default:
+		return nil, errors.Wrapf(ErrUnknownRateLimitBackend, "%q", b.config.RateLimit.Backend)
+	}
+
+	var err error
+
+	if conf.Default, err = ratelimit.ParseLimit(b.config.RateLimit.Default); err != nil {
+		return nil, errors.Wrap(err, "RATE_LIMIT_DEFAULT")
+	}
+
+	operations := spec.Analyzer.OperationMethodPaths()
+
+	for operation, raw := range b.config.RateLimit.Operations {
+		if !slices.Contains(operations, operation) {
+			return nil, errors.Wrapf(ErrUnknownRateLimitOperation, "%q", operation)
+		}
+
+		if conf.Operations[operation], err = ratelimit.ParseLimit(raw); err != nil {
+			return nil, errors.Wrapf(err, "RATE_LIMIT_OPERATIONS %q", operation)
+		}
+	}
+
+	for _, operation := range conf.Exempt {
+		if !slices.Contains(operations, operation) {
+			return nil, errors.Wrapf(ErrUnknownRateLimitOperation, "%q", operation)
+		}
+	}
+
+	conf.Rejected = promGo.NewCounterVec(promGo.CounterOpts{ //nolint:exhaustruct
+		Namespace: strings.ReplaceAll(b.config.App.Name, "-", "_"),
+		Name:      "rate_limit_rejected_requests_total",
+		Help:      "Requests rejected with 429 by the rate limiter.",
+	}, []string{"operation", "client"})
+
+	if err = b.prometheus().Register(conf.Rejected); err != nil {
+		return nil, errors.Wrap(err, "register rate limit metrics")
+	}
+
+	return restapi.NewRateLimiter(conf, authn, trusted, cookies), nil
+}
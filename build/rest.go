@@ -4,40 +4,123 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"otusgruz/internal/requestid"
 	"otusgruz/internal/restapi"
 	"otusgruz/internal/restapi/operations"
+	"otusgruz/internal/restapi/operations/api_keys"
+	"otusgruz/internal/restapi/operations/auth"
 	"otusgruz/internal/restapi/operations/other"
+	"otusgruz/internal/restapi/operations/sessions"
+	"otusgruz/internal/restapi/operations/two_factor"
 	"otusgruz/internal/restapi/operations/user_c_r_u_d"
+	"otusgruz/internal/restapi/operations/webhooks"
 	"otusgruz/internal/service/api/user"
+	"otusgruz/internal/service/api/webhook"
 
 	"github.com/go-openapi/loads"
 	mdlwr "github.com/go-openapi/runtime/middleware"
 	"github.com/pkg/errors"
 )
 
-func (b *Builder) buildAPI() (*operations.RestServerAPI, *loads.Document, error) {
+// buildAPI возвращает API с обработчиками, спецификацию и middleware ограничения частоты запросов.
+func (b *Builder) buildAPI() (*operations.RestServerAPI, *loads.Document, func(http.Handler) http.Handler, error) {
 	swaggerSpec, err := loads.Spec("api/swagger/file.yaml")
 	if err != nil {
-		return nil, nil, fmt.Errorf("load swagger specs: %w", err)
+		return nil, nil, nil, fmt.Errorf("load swagger specs: %w", err)
 	}
 
 	api := operations.NewRestServerAPI(swaggerSpec)
 
 	psql, err := b.PostgresClient()
 	if err != nil {
-		return nil, nil, fmt.Errorf("creating postgres client: %w", err)
+		return nil, nil, nil, fmt.Errorf("creating postgres client: %w", err)
 	}
 
 	repo := b.NewRepo(psql.DB)
 
-	userSrv := user.NewService(repo)
+	userSrv := user.NewService(user.NewRepo(psql.DB, repo), b.config.Idempotency.KeyTTL)
+	webhookSrv := webhook.NewService(webhook.NewRepo(repo))
 
-	handler := restapi.NewHandler(userSrv)
+	healthSrv, err := b.healthService(psql.DB)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating health service: %w", err)
+	}
+
+	if b.config.Auth.JWTSecret == "" {
+		return nil, nil, nil, ErrJWTSecretMissing
+	}
+
+	authSrv, err := b.authService(psql.DB, repo)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating auth service: %w", err)
+	}
+
+	trusted, err := b.trustedProxy()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	cookies, err := b.cookieSession(swaggerSpec.BasePath())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	rateLimitMW, err := b.rateLimiter(swaggerSpec, repo, authSrv, trusted, cookies)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	restapi.ConfigureAuth(api, authSrv, trusted, cookies)
+
+	handler := restapi.NewHandler(userSrv, healthSrv, authSrv, webhookSrv, trusted, cookies)
 
 	api.OtherGetHealthHandler = other.GetHealthHandlerFunc(
 		handler.GetHealth,
 	)
+	api.OtherGetHealthLiveHandler = other.GetHealthLiveHandlerFunc(
+		handler.GetHealthLive,
+	)
+	api.OtherGetHealthReadyHandler = other.GetHealthReadyHandlerFunc(
+		handler.GetHealthReady,
+	)
+
+	api.AuthPostAuthRegisterHandler = auth.PostAuthRegisterHandlerFunc(
+		handler.Register,
+	)
+	api.AuthPostAuthLoginHandler = auth.PostAuthLoginHandlerFunc(
+		handler.Login,
+	)
+	api.AuthPostAuthRefreshHandler = auth.PostAuthRefreshHandlerFunc(
+		handler.Refresh,
+	)
+	api.AuthPostAuthLogoutHandler = auth.PostAuthLogoutHandlerFunc(
+		handler.Logout,
+	)
+	api.AuthPostAuthVerifyEmailHandler = auth.PostAuthVerifyEmailHandlerFunc(
+		handler.VerifyEmail,
+	)
+	api.AuthPostAuthVerifyEmailResendHandler = auth.PostAuthVerifyEmailResendHandlerFunc(
+		handler.ResendVerification,
+	)
+	api.AuthPostAuthPasswordForgotHandler = auth.PostAuthPasswordForgotHandlerFunc(
+		handler.ForgotPassword,
+	)
+	api.AuthPostAuthPasswordResetHandler = auth.PostAuthPasswordResetHandlerFunc(
+		handler.ResetPassword,
+	)
+	api.AuthPostAuthMfaVerifyHandler = auth.PostAuthMfaVerifyHandlerFunc(
+		handler.VerifyMFA,
+	)
+	api.AuthGetAuthOidcProviderStartHandler = auth.GetAuthOidcProviderStartHandlerFunc(
+		handler.OIDCStart,
+	)
+	api.AuthGetAuthOidcProviderCallbackHandler = auth.GetAuthOidcProviderCallbackHandlerFunc(
+		handler.OIDCCallback,
+	)
 
+	api.UsercrudGetUserHandler = user_c_r_u_d.GetUserHandlerFunc(
+		handler.ListUsers,
+	)
 	api.UsercrudGetUserGUIDHandler = user_c_r_u_d.GetUserGUIDHandlerFunc(
 		handler.GetUser,
 	)
@@ -45,13 +128,85 @@ func (b *Builder) buildAPI() (*operations.RestServerAPI, *loads.Document, error)
 		handler.DeleteUser,
 	)
 	api.UsercrudPatchUserGUIDHandler = user_c_r_u_d.PatchUserGUIDHandlerFunc(
+		handler.PatchUser,
+	)
+	api.UsercrudPutUserGUIDHandler = user_c_r_u_d.PutUserGUIDHandlerFunc(
 		handler.UpdateUser,
 	)
 	api.UsercrudPostUserHandler = user_c_r_u_d.PostUserHandlerFunc(
 		handler.CreateUser,
 	)
+	api.UsercrudPostUserGUIDRestoreHandler = user_c_r_u_d.PostUserGUIDRestoreHandlerFunc(
+		handler.RestoreUser,
+	)
+	api.UsercrudGetUserGUIDHistoryHandler = user_c_r_u_d.GetUserGUIDHistoryHandlerFunc(
+		handler.UserHistory,
+	)
+
+	api.SessionsGetUserGUIDSessionsHandler = sessions.GetUserGUIDSessionsHandlerFunc(
+		handler.ListSessions,
+	)
+	api.SessionsDeleteUserGUIDSessionsIDHandler = sessions.DeleteUserGUIDSessionsIDHandlerFunc(
+		handler.RevokeSession,
+	)
+
+	api.TwofactorGetUserGUIDMfaHandler = two_factor.GetUserGUIDMfaHandlerFunc(
+		handler.MFAStatus,
+	)
+	api.TwofactorPostUserGUIDMfaTotpHandler = two_factor.PostUserGUIDMfaTotpHandlerFunc(
+		handler.EnrollTOTP,
+	)
+	api.TwofactorGetUserGUIDMfaTotpQrHandler = two_factor.GetUserGUIDMfaTotpQrHandlerFunc(
+		handler.TOTPQRCode,
+	)
+	api.TwofactorPostUserGUIDMfaTotpConfirmHandler = two_factor.PostUserGUIDMfaTotpConfirmHandlerFunc(
+		handler.ConfirmTOTP,
+	)
+	api.TwofactorDeleteUserGUIDMfaTotpHandler = two_factor.DeleteUserGUIDMfaTotpHandlerFunc(
+		handler.DisableTOTP,
+	)
+	api.TwofactorPostUserGUIDMfaRecoveryCodesHandler = two_factor.PostUserGUIDMfaRecoveryCodesHandlerFunc(
+		handler.RegenerateRecoveryCodes,
+	)
+
+	api.WebhooksGetWebhooksHandler = webhooks.GetWebhooksHandlerFunc(
+		handler.ListWebhooks,
+	)
+	api.WebhooksPostWebhooksHandler = webhooks.PostWebhooksHandlerFunc(
+		handler.CreateWebhook,
+	)
+	api.WebhooksGetWebhooksIDHandler = webhooks.GetWebhooksIDHandlerFunc(
+		handler.GetWebhook,
+	)
+	api.WebhooksPutWebhooksIDHandler = webhooks.PutWebhooksIDHandlerFunc(
+		handler.UpdateWebhook,
+	)
+	api.WebhooksDeleteWebhooksIDHandler = webhooks.DeleteWebhooksIDHandlerFunc(
+		handler.DeleteWebhook,
+	)
+	api.WebhooksGetWebhooksIDDeliveriesHandler = webhooks.GetWebhooksIDDeliveriesHandlerFunc(
+		handler.WebhookDeliveries,
+	)
+	api.WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler = webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverHandlerFunc(
+		handler.RedeliverWebhook,
+	)
+
+	api.ApikeysGetAPIKeysHandler = api_keys.GetAPIKeysHandlerFunc(
+		handler.ListAPIKeys,
+	)
+	api.ApikeysPostAPIKeysHandler = api_keys.PostAPIKeysHandlerFunc(
+		handler.CreateAPIKey,
+	)
+	api.ApikeysGetAPIKeysIDHandler = api_keys.GetAPIKeysIDHandlerFunc(
+		handler.GetAPIKey,
+	)
+	api.ApikeysDeleteAPIKeysIDHandler = api_keys.DeleteAPIKeysIDHandlerFunc(
+		handler.RevokeAPIKey,
+	)
+
+	restapi.ConfigureConsumers(api)
 
-	return api, swaggerSpec, nil
+	return api, swaggerSpec, rateLimitMW, nil
 }
 
 //nolint:funlen
@@ -63,7 +218,7 @@ func (b *Builder) RestAPIServer(ctx context.Context) (*http.Server, error) {
 
 	router := b.httpRouter()
 
-	api, swaggerSpec, err := b.buildAPI()
+	api, swaggerSpec, rateLimitMW, err := b.buildAPI()
 	if err != nil {
 		return nil, errors.Wrap(err, "building API")
 	}
@@ -76,7 +231,7 @@ func (b *Builder) RestAPIServer(ctx context.Context) (*http.Server, error) {
 		return nil, fmt.Errorf("creating metrics middleware: %w", err)
 	}
 
-	apiRouter.Use(metricsMW)
+	apiRouter.Use(requestid.Middleware, metricsMW)
 
 	swaggerUIOpts := mdlwr.SwaggerUIOpts{ //nolint:exhaustruct
 		BasePath: apiEndpoint,
@@ -92,7 +247,9 @@ func (b *Builder) RestAPIServer(ctx context.Context) (*http.Server, error) {
 				swaggerSpec.Raw(),
 				mdlwr.SwaggerUI(
 					swaggerUIOpts,
-					api.Context().RoutesHandler(metricsMW),
+					api.Context().RoutesHandler(func(next http.Handler) http.Handler {
+						return metricsMW(rateLimitMW(next))
+					}),
 				),
 			)
 		}(),
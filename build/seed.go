@@ -0,0 +1,26 @@
+package build
+
+import (
+	"otusgruz/internal/seed"
+	"otusgruz/internal/service/api/user"
+
+	"github.com/pkg/errors"
+)
+
+func (b *Builder) Seeder() (*seed.Seeder, error) {
+	userSrv, err := b.UserService()
+	if err != nil {
+		return nil, err
+	}
+
+	return seed.NewSeeder(userSrv), nil
+}
+
+func (b *Builder) UserService() (user.Service, error) {
+	psql, err := b.PostgresClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating postgres client")
+	}
+
+	return user.NewService(user.NewRepo(psql.DB, b.NewRepo(psql.DB)), b.config.Idempotency.KeyTTL), nil
+}
@@ -2,29 +2,93 @@ package build
 
 import (
 	"context"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
+// Shutdown останавливает приложение: снимает readiness, дожидается завершения активных
+// HTTP запросов и вызывает зарегистрированные хуки в обратном порядке. Если HTTP сервер так и не
+// начал принимать соединения, например адрес занят, трафика нет и ожидание Shutdown.Delay пропускается.
 func (b *Builder) Shutdown(ctx context.Context) {
-	zerolog.Ctx(ctx).Info().Msgf("got os signal. application will be stopped")
-	b.shutdown.do(ctx)
+	logger := zerolog.Ctx(ctx)
+	logger.Info().Msgf("got os signal. application will be stopped")
+
+	b.shuttingDown.Store(true)
+
+	if b.http.server != nil && b.http.serving.Load() {
+		logger.Info().Dur("delay", b.config.Shutdown.Delay).Msg("readiness disabled, waiting for traffic to drain")
+		time.Sleep(b.config.Shutdown.Delay)
+
+		drainCtx, cancel := context.WithTimeout(ctx, b.config.Shutdown.Timeout)
+		defer cancel()
+
+		if err := b.http.server.Shutdown(drainCtx); err != nil {
+			logger.Err(err).Msg("http server did not drain in time, closing connections")
+
+			if err = b.http.server.Close(); err != nil {
+				logger.Err(err).Msg("close http server")
+			}
+		} else {
+			logger.Info().Msg("http server stopped")
+		}
+	}
+
+	b.shutdown.do(ctx, b.config.Shutdown.HookTimeout)
+}
+
+// ShuttingDown сообщает, что приложение начало остановку и не должно получать новый трафик.
+func (b *Builder) ShuttingDown() bool {
+	return b.shuttingDown.Load()
 }
 
 type shutdownFn func(context.Context) error
 
+type shutdownHook struct {
+	name string
+	fn   shutdownFn
+}
+
 type shutdown struct {
-	fn []shutdownFn
+	hooks []shutdownHook
 }
 
-func (s *shutdown) add(fn shutdownFn) {
-	s.fn = append(s.fn, fn)
+func (s *shutdown) add(name string, fn shutdownFn) {
+	s.hooks = append(s.hooks, shutdownHook{name: name, fn: fn})
 }
 
-func (s *shutdown) do(ctx context.Context) {
-	for i := len(s.fn) - 1; i >= 0; i-- {
-		if err := s.fn[i](ctx); err != nil {
-			zerolog.Ctx(ctx).Err(err).Send()
+func (s *shutdown) do(ctx context.Context, timeout time.Duration) {
+	for i := len(s.hooks) - 1; i >= 0; i-- {
+		hook := s.hooks[i]
+		start := time.Now()
+
+		err := runWithTimeout(ctx, timeout, hook.fn)
+
+		event := zerolog.Ctx(ctx).Info()
+		if err != nil {
+			event = zerolog.Ctx(ctx).Error().Err(err)
 		}
+
+		event.Str("hook", hook.name).Dur("elapsed", time.Since(start)).Msg("shutdown hook finished")
+	}
+}
+
+// runWithTimeout не дает зависшему хуку заблокировать остановку остальных.
+func runWithTimeout(ctx context.Context, timeout time.Duration, fn shutdownFn) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "shutdown hook timed out")
 	}
 }
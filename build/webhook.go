@@ -0,0 +1,27 @@
+package build
+
+import (
+	"github.com/pkg/errors"
+
+	"otusgruz/internal/webhook"
+)
+
+func (b *Builder) WebhookDispatcher() (*webhook.Dispatcher, error) {
+	psql, err := b.PostgresClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating postgres client")
+	}
+
+	return webhook.NewDispatcher(
+		webhook.NewRepo(psql.DB, b.NewRepo(psql.DB)),
+		webhook.NewClient(b.config.Webhook.Timeout, b.config.Webhook.AllowPrivateAddresses),
+		webhook.Options{
+			BatchSize:    b.config.Webhook.BatchSize,
+			PollInterval: b.config.Webhook.PollInterval,
+			Timeout:      b.config.Webhook.Timeout,
+			MaxAttempts:  b.config.Webhook.MaxAttempts,
+			BackoffBase:  b.config.Webhook.BackoffBase,
+			BackoffMax:   b.config.Webhook.BackoffMax,
+		},
+	), nil
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+)
+
+func authCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   "auth",
+		Short: "start auth endpoint for nginx auth-request",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			builder := build.New(ctx, conf)
+
+			server, err := builder.EdgeAuthServer(ctx)
+			if err != nil {
+				return errors.Wrap(err, "build edge auth server")
+			}
+
+			return serve(ctx, builder, server)
+		},
+	}
+}
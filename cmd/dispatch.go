@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+)
+
+func dispatchCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	var once bool
+
+	command := &cobra.Command{ //nolint:exhaustruct
+		Use:   "dispatch",
+		Short: "deliver webhook events until SIGINT/SIGTERM",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			builder := build.New(ctx, conf)
+			defer builder.Shutdown(ctx)
+
+			dispatcher, err := builder.WebhookDispatcher()
+			if err != nil {
+				return errors.Wrap(err, "build webhook dispatcher")
+			}
+
+			if once {
+				claimed, err := dispatcher.DispatchOnce(ctx)
+				if err != nil {
+					return errors.Wrap(err, "dispatch webhook deliveries")
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "attempted %d deliveries\n", claimed)
+
+				return nil
+			}
+
+			signalCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+
+			return errors.Wrap(dispatcher.Run(signalCtx), "dispatch webhooks")
+		},
+	}
+
+	command.Flags().BoolVar(&once, "once", false, "attempt a single batch and exit")
+
+	return command
+}
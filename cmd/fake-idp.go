@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+)
+
+func fakeIDPCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   "fake-idp",
+		Short: "start in-memory OIDC provider for local SSO login",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			builder := build.New(ctx, conf)
+
+			server, err := builder.FakeIDPServer(ctx)
+			if err != nil {
+				return errors.Wrap(err, "build fake idp server")
+			}
+
+			return serve(ctx, builder, server)
+		},
+	}
+}
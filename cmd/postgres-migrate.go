@@ -2,6 +2,14 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	migrate "github.com/golang-migrate/migrate/v4"
 	"github.com/pkg/errors"
@@ -9,8 +17,11 @@ import (
 
 	"otusgruz/build"
 	"otusgruz/config"
+	"otusgruz/internal/migration"
 )
 
+var errConfirmationRequired = errors.New("destructive command, pass --yes to confirm")
+
 func postgresCmd(ctx context.Context, conf config.Config) *cobra.Command {
 	command := &cobra.Command{ //nolint:exhaustruct
 		Use:   "postgres",
@@ -21,7 +32,15 @@ func postgresCmd(ctx context.Context, conf config.Config) *cobra.Command {
 		},
 	}
 
-	command.AddCommand(up(ctx, conf, postgres))
+	command.AddCommand(
+		up(ctx, conf, postgres),
+		down(ctx, conf, postgres),
+		gotoVersion(ctx, conf, postgres),
+		force(ctx, conf, postgres),
+		version(ctx, conf, postgres),
+		status(ctx, conf, postgres, migration.PostgresPath),
+		create("internal/migration/postgres"),
+	)
 
 	return command
 }
@@ -30,28 +49,234 @@ func postgres(ctx context.Context, conf config.Config) (*migrate.Migrate, error)
 	b := build.New(ctx, conf)
 
 	//nolint:wrapcheck
-	return b.PostgresMigration()
+	return b.PostgresMigration(ctx)
 }
 
 type migrationConstructFn func(context.Context, config.Config) (*migrate.Migrate, error)
 
+func withMigration(
+	ctx context.Context,
+	conf config.Config,
+	constructFn migrationConstructFn,
+	fn func(m *migrate.Migrate) error,
+) error {
+	m, err := constructFn(ctx, conf)
+	if err != nil {
+		return errors.Wrap(err, "construct migration")
+	}
+
+	defer m.Close()
+
+	return fn(m)
+}
+
+func confirmFlag(command *cobra.Command, yes *bool) *cobra.Command {
+	command.Flags().BoolVar(yes, "yes", false, "confirm destructive operation")
+
+	return command
+}
+
 func up(ctx context.Context, conf config.Config, constructFn migrationConstructFn) *cobra.Command {
 	return &cobra.Command{ //nolint:exhaustruct
 		Use:   "up",
 		Short: "up migrations",
 		RunE: func(_ *cobra.Command, _ []string) error {
-			m, err := constructFn(ctx, conf)
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				err := m.Up()
+				if err != nil {
+					if errors.Is(err, migrate.ErrNoChange) || errors.Is(err, migrate.ErrNilVersion) {
+						return nil
+					}
+
+					return errors.Wrap(err, "up migrations")
+				}
+
+				return nil
+			})
+		},
+	}
+}
+
+func down(ctx context.Context, conf config.Config, constructFn migrationConstructFn) *cobra.Command {
+	var yes bool
+
+	return confirmFlag(&cobra.Command{ //nolint:exhaustruct
+		Use:   "down [N]",
+		Short: "roll back N migrations (default 1)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			steps := 1
+
+			if len(args) == 1 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 1 {
+					return errors.Errorf("invalid number of steps %q", args[0])
+				}
+
+				steps = n
+			}
+
+			if !yes {
+				return errConfirmationRequired
+			}
+
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				if err := m.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+					return errors.Wrap(err, "down migrations")
+				}
+
+				return nil
+			})
+		},
+	}, &yes)
+}
+
+func gotoVersion(ctx context.Context, conf config.Config, constructFn migrationConstructFn) *cobra.Command {
+	var yes bool
+
+	return confirmFlag(&cobra.Command{ //nolint:exhaustruct
+		Use:   "goto V",
+		Short: "migrate up or down to version V",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			v, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return errors.Wrap(err, "construct migration")
+				return errors.Wrapf(err, "invalid version %q", args[0])
 			}
 
-			err = m.Up()
+			if !yes {
+				return errConfirmationRequired
+			}
+
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				if err := m.Migrate(uint(v)); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+					return errors.Wrapf(err, "migrate to version %d", v)
+				}
+
+				return nil
+			})
+		},
+	}, &yes)
+}
+
+func force(ctx context.Context, conf config.Config, constructFn migrationConstructFn) *cobra.Command {
+	var yes bool
+
+	return confirmFlag(&cobra.Command{ //nolint:exhaustruct
+		Use:   "force V",
+		Short: "set version V and clear dirty state without running migrations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			v, err := strconv.Atoi(args[0])
 			if err != nil {
-				if errors.Is(err, migrate.ErrNoChange) || errors.Is(err, migrate.ErrNilVersion) {
+				return errors.Wrapf(err, "invalid version %q", args[0])
+			}
+
+			if !yes {
+				return errConfirmationRequired
+			}
+
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				return errors.Wrapf(m.Force(v), "force version %d", v)
+			})
+		},
+	}, &yes)
+}
+
+func version(ctx context.Context, conf config.Config, constructFn migrationConstructFn) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   "version",
+		Short: "print applied migration version",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				v, dirty, err := m.Version()
+				if errors.Is(err, migrate.ErrNilVersion) {
+					fmt.Fprintln(cmd.OutOrStdout(), "no migrations applied")
+
 					return nil
 				}
 
-				return errors.Wrap(err, "up migrations")
+				if err != nil {
+					return errors.Wrap(err, "read version")
+				}
+
+				if dirty {
+					fmt.Fprintf(cmd.OutOrStdout(), "%d (dirty)\n", v)
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "%d\n", v)
+				}
+
+				return nil
+			})
+		},
+	}
+}
+
+func status(ctx context.Context, conf config.Config, constructFn migrationConstructFn, path string) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   "status",
+		Short: "list embedded migrations with applied/pending/dirty state",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			migrations, err := migration.List(path)
+			if err != nil {
+				return errors.Wrap(err, "list embedded migrations")
+			}
+
+			return withMigration(ctx, conf, constructFn, func(m *migrate.Migrate) error {
+				current, dirty, err := m.Version()
+				if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+					return errors.Wrap(err, "read version")
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+				for _, mg := range migrations {
+					state := "pending"
+
+					switch {
+					case mg.Version == current && dirty:
+						state = "dirty"
+					case err == nil && mg.Version <= current:
+						state = "applied"
+					}
+
+					fmt.Fprintf(w, "%d\t%s\t%s\n", mg.Version, mg.Name, state)
+				}
+
+				return errors.Wrap(w.Flush(), "write status")
+			})
+		},
+	}
+}
+
+var migrationNameRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+func create(dir string) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   "create NAME",
+		Short: "scaffold timestamped up/down migration files",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.Trim(migrationNameRe.ReplaceAllString(strings.ToLower(args[0]), "_"), "_")
+			if name == "" {
+				return errors.Errorf("invalid migration name %q", args[0])
+			}
+
+			prefix := fmt.Sprintf("%s_%s", time.Now().UTC().Format("20060102150405"), name)
+
+			for _, direction := range []string{"up", "down"} {
+				file := filepath.Join(dir, fmt.Sprintf("%s.%s.sql", prefix, direction))
+
+				f, err := os.OpenFile(file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644) //nolint:gosec
+				if err != nil {
+					return errors.Wrap(err, "create migration file")
+				}
+
+				if err = f.Close(); err != nil {
+					return errors.Wrap(err, "close migration file")
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), file)
 			}
 
 			return nil
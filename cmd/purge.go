@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+)
+
+var errInvalidRetention = errors.New("retention must be positive")
+
+func purgeCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	var yes bool
+
+	retention, batchSize := conf.Purge.Retention, conf.Purge.BatchSize
+
+	command := confirmFlag(&cobra.Command{ //nolint:exhaustruct
+		Use:   "purge",
+		Short: "hard delete users soft-deleted longer than the retention period",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !yes {
+				return errConfirmationRequired
+			}
+
+			if retention <= 0 {
+				return errInvalidRetention
+			}
+
+			userSrv, err := build.New(ctx, conf).UserService()
+			if err != nil {
+				return errors.Wrap(err, "build user service")
+			}
+
+			before := time.Now().Add(-retention)
+
+			purged, err := userSrv.PurgeDeleted(ctx, before, batchSize)
+			if err != nil {
+				return errors.Wrapf(err, "purge users deleted before %s, %d already purged", before.Format(time.RFC3339), purged)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "purged %d users deleted before %s\n", purged, before.Format(time.RFC3339))
+
+			return nil
+		},
+	}, &yes)
+
+	command.Flags().DurationVar(&retention, "retention", retention, "purge users deleted longer than this, defaults to PURGE_RETENTION")
+	command.Flags().Int32Var(&batchSize, "batch-size", batchSize, "users deleted per query, defaults to PURGE_BATCH_SIZE")
+
+	return command
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+)
+
+func relayCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	var once bool
+
+	command := &cobra.Command{ //nolint:exhaustruct
+		Use:   "relay",
+		Short: "publish outbox events until SIGINT/SIGTERM",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			builder := build.New(ctx, conf)
+			defer builder.Shutdown(ctx)
+
+			relay, err := builder.OutboxRelay()
+			if err != nil {
+				return errors.Wrap(err, "build outbox relay")
+			}
+
+			if once {
+				published, err := relay.RelayOnce(ctx)
+				if err != nil {
+					return errors.Wrap(err, "relay outbox batch")
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "published %d events\n", published)
+
+				return nil
+			}
+
+			signalCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+
+			return errors.Wrap(relay.Run(signalCtx), "relay outbox")
+		},
+	}
+
+	command.Flags().BoolVar(&once, "once", false, "publish a single batch and exit")
+
+	return command
+}
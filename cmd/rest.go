@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"net/http"
+	"os/signal"
+	"syscall"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -12,26 +14,59 @@ import (
 )
 
 func restCmd(ctx context.Context, conf config.Config) *cobra.Command {
-	return &cobra.Command{ //nolint:exhaustruct
+	var migrateOnStart bool
+
+	command := &cobra.Command{ //nolint:exhaustruct
 		Use:   "rest",
 		Short: "start rest server",
 		RunE: func(_ *cobra.Command, _ []string) error {
 			builder := build.New(ctx, conf)
-			ctx, cancel := context.WithCancel(ctx)
-			defer cancel()
 
+			if migrateOnStart {
+				if err := builder.MigratePostgres(ctx); err != nil {
+					return errors.Wrap(err, "migrate postgres")
+				}
+			}
+
+			// контекст сервера не отменяется сигналом, чтобы активные запросы могли завершиться
 			server, err := builder.RestAPIServer(ctx)
 			if err != nil {
 				return errors.Wrap(err, "build rest api server")
 			}
 
-			if err = server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-				return errors.Wrap(err, "rest api server serve")
-			}
+			return serve(ctx, builder, server)
+		},
+	}
 
-			<-ctx.Done()
+	command.Flags().BoolVar(&migrateOnStart, "migrate", conf.Postgres.MigrateOnStart,
+		"apply pending postgres migrations before starting the server")
 
-			return nil
-		},
+	return command
+}
+
+// serve обслуживает запросы до SIGINT/SIGTERM, после чего выполняет graceful shutdown.
+func serve(ctx context.Context, builder *build.Builder, server *http.Server) error {
+	signalCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		defer close(serveErr)
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- errors.Wrap(err, "http server serve")
+		}
+	}()
+
+	var err error
+
+	select {
+	case err = <-serveErr:
+	case <-signalCtx.Done():
 	}
+
+	builder.Shutdown(ctx)
+
+	return err
 }
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+	"otusgruz/internal/service/api/auth"
+)
+
+func rolesCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	command := &cobra.Command{ //nolint:exhaustruct
+		Use:   "roles",
+		Short: "manage user roles (" + auth.RoleUser + ", " + auth.RoleAdmin + ", " + auth.RoleService + ")",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck
+			return cmd.Usage()
+		},
+	}
+
+	command.AddCommand(
+		roleChange(ctx, conf, "grant", "grant ROLE to user GUID", auth.Service.GrantRole),
+		roleChange(ctx, conf, "revoke", "revoke ROLE from user GUID", auth.Service.RevokeRole),
+	)
+
+	return command
+}
+
+type roleChangeFn func(auth.Service, context.Context, uuid.UUID, string) error
+
+func roleChange(ctx context.Context, conf config.Config, use, short string, fn roleChangeFn) *cobra.Command {
+	return &cobra.Command{ //nolint:exhaustruct
+		Use:   use + " GUID ROLE",
+		Short: short,
+		Args:  cobra.ExactArgs(2), //nolint:mnd
+		RunE: func(_ *cobra.Command, args []string) error {
+			guid, err := uuid.Parse(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "invalid user guid %q", args[0])
+			}
+
+			authSrv, err := build.New(ctx, conf).AuthService()
+			if err != nil {
+				return errors.Wrap(err, "build auth service")
+			}
+
+			return errors.Wrapf(fn(authSrv, ctx, guid, args[1]), "%s role", use)
+		},
+	}
+}
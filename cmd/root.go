@@ -18,8 +18,15 @@ func Run(ctx context.Context, conf config.Config) error {
 	}
 
 	root.AddCommand(
+		authCmd(ctx, conf),
+		dispatchCmd(ctx, conf),
+		fakeIDPCmd(ctx, conf),
 		postgresCmd(ctx, conf),
+		purgeCmd(ctx, conf),
+		relayCmd(ctx, conf),
 		restCmd(ctx, conf),
+		rolesCmd(ctx, conf),
+		seedCmd(ctx, conf),
 	)
 
 	return errors.Wrap(root.ExecuteContext(ctx), "run application")
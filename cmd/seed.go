@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"otusgruz/build"
+	"otusgruz/config"
+	"otusgruz/internal/seed"
+)
+
+type seedOptions struct {
+	env      string
+	path     string
+	embedded bool
+}
+
+func seedCmd(ctx context.Context, conf config.Config) *cobra.Command {
+	opts := seedOptions{env: string(conf.App.ENV), embedded: true} //nolint:exhaustruct
+
+	command := &cobra.Command{ //nolint:exhaustruct
+		Use:   "seed",
+		Short: "load fixtures for the environment",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fixtures, err := opts.load()
+			if err != nil {
+				return err
+			}
+
+			seeder, err := build.New(ctx, conf).Seeder()
+			if err != nil {
+				return errors.Wrap(err, "build seeder")
+			}
+
+			created, err := seeder.Apply(ctx, fixtures)
+			if err != nil {
+				return errors.Wrap(err, "apply fixtures")
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "created %d of %d users\n", created, len(fixtures.Users))
+
+			return nil
+		},
+	}
+
+	command.PersistentFlags().StringVar(&opts.env, "env", opts.env, "fixture set to use, defaults to APP_ENV")
+	command.PersistentFlags().StringVar(&opts.path, "path", "", "additional fixture file or directory")
+	command.PersistentFlags().BoolVar(&opts.embedded, "embedded", true, "use fixtures embedded into the binary")
+
+	command.AddCommand(seedPurge(ctx, conf, &opts))
+
+	return command
+}
+
+func seedPurge(ctx context.Context, conf config.Config, opts *seedOptions) *cobra.Command {
+	var yes bool
+
+	return confirmFlag(&cobra.Command{ //nolint:exhaustruct
+		Use:   "purge",
+		Short: "delete records listed in fixtures",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !yes {
+				return errConfirmationRequired
+			}
+
+			fixtures, err := opts.load()
+			if err != nil {
+				return err
+			}
+
+			seeder, err := build.New(ctx, conf).Seeder()
+			if err != nil {
+				return errors.Wrap(err, "build seeder")
+			}
+
+			purged, err := seeder.Purge(ctx, fixtures)
+			if err != nil {
+				return errors.Wrap(err, "purge fixtures")
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "purged %d of %d users\n", purged, len(fixtures.Users))
+
+			return nil
+		},
+	}, &yes)
+}
+
+func (o *seedOptions) load() (seed.Fixtures, error) {
+	var res seed.Fixtures
+
+	if o.embedded {
+		embedded, err := seed.Embedded(o.env)
+		if err != nil {
+			return res, errors.Wrap(err, "load embedded fixtures")
+		}
+
+		res.Users = append(res.Users, embedded.Users...)
+	}
+
+	if o.path != "" {
+		external, err := seed.FromPath(o.path)
+		if err != nil {
+			return res, errors.Wrap(err, "load fixtures from path")
+		}
+
+		res.Users = append(res.Users, external.Users...)
+	}
+
+	return res, nil
+}
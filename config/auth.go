@@ -0,0 +1,40 @@
+package config
+
+import "time"
+
+type Auth struct {
+	// JWTSecret ключ подписи access token (HS256), обязателен для rest сервера.
+	JWTSecret  string        `envconfig:"AUTH_JWT_SECRET" default:""`
+	JWTIssuer  string        `envconfig:"AUTH_JWT_ISSUER" default:"otusgruz"`
+	AccessTTL  time.Duration `envconfig:"AUTH_ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTTL time.Duration `envconfig:"AUTH_REFRESH_TOKEN_TTL" default:"720h"`
+	BcryptCost int           `envconfig:"AUTH_BCRYPT_COST" default:"10"`
+
+	// SessionCheckTTL срок, в течение которого rest сервер переиспользует проверку, что сессия access token
+	// не завершена. Edge-аутентификация сессии не проверяет, для нее токен действует до истечения AccessTTL.
+	SessionCheckTTL time.Duration `envconfig:"AUTH_SESSION_CHECK_TTL" default:"30s"`
+
+	// VerifyEmailTTL и PasswordResetTTL срок действия токенов из писем.
+	VerifyEmailTTL   time.Duration `envconfig:"AUTH_VERIFY_EMAIL_TTL" default:"24h"`
+	PasswordResetTTL time.Duration `envconfig:"AUTH_PASSWORD_RESET_TTL" default:"1h"`
+
+	// MFAIssuer название сервиса в приложении-аутентификаторе.
+	MFAIssuer string `envconfig:"AUTH_MFA_ISSUER" default:"Otusgruz"`
+	// MFAChallengeTTL срок на ввод кода второго фактора после пароля.
+	MFAChallengeTTL time.Duration `envconfig:"AUTH_MFA_CHALLENGE_TTL" default:"5m"`
+	// MFAMaxAttempts неверных кодов подряд, после которых проверка кодов блокируется на MFALockout.
+	MFAMaxAttempts int           `envconfig:"AUTH_MFA_MAX_ATTEMPTS" default:"5"`
+	MFALockout     time.Duration `envconfig:"AUTH_MFA_LOCKOUT" default:"15m"`
+
+	// SessionMode bearer - токены в теле ответа и заголовке Authorization, cookie - в HttpOnly cookie с CSRF токеном.
+	SessionMode string `envconfig:"AUTH_SESSION_MODE" default:"bearer"`
+	// CookieSecure, CookieSameSite (strict, lax, none) и CookieDomain атрибуты cookie в режиме cookie.
+	CookieSecure   bool   `envconfig:"AUTH_COOKIE_SECURE" default:"true"`
+	CookieSameSite string `envconfig:"AUTH_COOKIE_SAMESITE" default:"strict"`
+	CookieDomain   string `envconfig:"AUTH_COOKIE_DOMAIN" default:""`
+
+	// TrustedProxy принимать X-User-Id / X-User-Roles от nginx, выполнившего проверку через `auth`.
+	TrustedProxy bool `envconfig:"AUTH_TRUSTED_PROXY" default:"false"`
+	// TrustedProxyCIDRs адреса, от которых принимаются эти заголовки, обязательны при AUTH_TRUSTED_PROXY=true.
+	TrustedProxyCIDRs []string `envconfig:"AUTH_TRUSTED_PROXY_CIDRS" default:""`
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -10,9 +11,20 @@ import (
 )
 
 type Config struct {
-	App      App
-	HTTP     HTTP
-	Postgres Postgres
+	App         App
+	HTTP        HTTP
+	Postgres    Postgres
+	Shutdown    Shutdown
+	Health      Health
+	Auth        Auth
+	Purge       Purge
+	Idempotency Idempotency
+	Outbox      Outbox
+	Kafka       Kafka
+	Webhook     Webhook
+	Mail        Mail
+	OIDC        OIDC
+	RateLimit   RateLimit
 }
 
 type appEnv string
@@ -33,6 +45,11 @@ type HTTP struct {
 	Schemes []string `envconfig:"HTTP_SCHEMES" default:"http"`
 }
 
+type Health struct {
+	CheckTimeout time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"1s"`
+	CacheTTL     time.Duration `envconfig:"HEALTH_CACHE_TTL" default:"2s"`
+}
+
 func Load() (Config, error) {
 	cnf := Config{} //nolint:exhaustruct
 
@@ -44,6 +61,10 @@ func Load() (Config, error) {
 		return cnf, errors.Wrap(err, "read environment")
 	}
 
+	if err := cnf.OIDC.loadProviders(); err != nil {
+		return cnf, errors.Wrap(err, "read environment")
+	}
+
 	return cnf, nil
 }
 
@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type Idempotency struct {
+	// KeyTTL сколько Idempotency-Key защищает от повторного создания пользователя, после этого ключ
+	// удаляется и может быть использован заново.
+	KeyTTL time.Duration `envconfig:"IDEMPOTENCY_KEY_TTL" default:"24h"`
+}
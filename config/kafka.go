@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type Kafka struct {
+	Brokers  []string `envconfig:"KAFKA_BROKERS" default:"localhost:9092"`
+	ClientID string   `envconfig:"KAFKA_CLIENT_ID" default:"otusgruz"`
+	Topic    string   `envconfig:"KAFKA_TOPIC" default:"user-events"`
+	// Acks подтверждения записи: all, leader или none. При none идемпотентная запись отключается.
+	Acks string `envconfig:"KAFKA_ACKS" default:"all"`
+
+	// Linger сколько продюсер ждет наполнения пачки перед отправкой.
+	Linger        time.Duration `envconfig:"KAFKA_LINGER" default:"5ms"`
+	BatchMaxBytes int32         `envconfig:"KAFKA_BATCH_MAX_BYTES" default:"1048576"`
+	// Retries количество повторов отправки записи, после которых Publish вернет ошибку.
+	Retries        int           `envconfig:"KAFKA_RETRIES" default:"10"`
+	ProduceTimeout time.Duration `envconfig:"KAFKA_PRODUCE_TIMEOUT" default:"10s"`
+}
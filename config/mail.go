@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type Mail struct {
+	// Transport способ отправки писем: smtp или file. file складывает письма в каталог Dir для локальной разработки.
+	Transport string `envconfig:"MAIL_TRANSPORT" default:"file"`
+	Dir       string `envconfig:"MAIL_DIR" default:"mail"`
+	From      string `envconfig:"MAIL_FROM" default:"Otusgruz <no-reply@otusgruz.local>"`
+
+	SMTPAddr     string        `envconfig:"MAIL_SMTP_ADDR" default:"localhost:587"`
+	SMTPUsername string        `envconfig:"MAIL_SMTP_USERNAME" default:""`
+	SMTPPassword string        `envconfig:"MAIL_SMTP_PASSWORD" default:""`
+	SMTPTimeout  time.Duration `envconfig:"MAIL_SMTP_TIMEOUT" default:"10s"`
+
+	// LinkBaseURL адрес фронтенда, к которому добавляются пути ссылок из писем: /verify-email и /reset-password.
+	LinkBaseURL string `envconfig:"MAIL_LINK_BASE_URL" default:"http://localhost:8080"`
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+)
+
+type OIDC struct {
+	// Providers имена провайдеров входа через SSO, настройки каждого читаются из OIDC_<ИМЯ>_*.
+	Providers    []string      `envconfig:"OIDC_PROVIDERS" default:""`
+	StateTTL     time.Duration `envconfig:"OIDC_STATE_TTL" default:"10m"`
+	JWKSCacheTTL time.Duration `envconfig:"OIDC_JWKS_CACHE_TTL" default:"1h"`
+	HTTPTimeout  time.Duration `envconfig:"OIDC_HTTP_TIMEOUT" default:"10s"`
+
+	// FakeIssuer адрес, по которому доступна команда fake-idp, для локальной разработки.
+	FakeIssuer string `envconfig:"OIDC_FAKE_ISSUER" default:"http://localhost:8080"`
+
+	// ProviderConfigs заполняется в Load по списку Providers.
+	ProviderConfigs map[string]OIDCProvider `ignored:"true"`
+}
+
+type OIDCProvider struct {
+	Issuer       string `envconfig:"ISSUER" required:"true"`
+	ClientID     string `envconfig:"CLIENT_ID" required:"true"`
+	ClientSecret string `envconfig:"CLIENT_SECRET" default:""`
+	// RedirectURL зарегистрированный у провайдера адрес возврата: /auth/oidc/{provider}/callback API
+	// или страница фронтенда, передающая code и state в этот метод.
+	RedirectURL string   `envconfig:"REDIRECT_URL" required:"true"`
+	Scopes      []string `envconfig:"SCOPES" default:"openid,email,profile"`
+	// LinkByEmail привязывать вход к существующему пользователю с тем же подтвержденным провайдером email.
+	// Включайте только для провайдеров, которым доверяете проверку адресов.
+	LinkByEmail bool `envconfig:"LINK_BY_EMAIL" default:"false"`
+}
+
+func (o *OIDC) loadProviders() error {
+	o.ProviderConfigs = make(map[string]OIDCProvider, len(o.Providers))
+
+	for _, name := range o.Providers {
+		var p OIDCProvider
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		if err := envconfig.Process(prefix, &p); err != nil {
+			return errors.Wrapf(err, "oidc provider %q", name)
+		}
+
+		o.ProviderConfigs[name] = p
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package config
+
+import "time"
+
+type Outbox struct {
+	// Publisher куда relay публикует события: stdout, file или kafka.
+	Publisher string `envconfig:"OUTBOX_PUBLISHER" default:"stdout"`
+	// File путь к файлу для публикатора file.
+	File string `envconfig:"OUTBOX_FILE" default:"outbox.jsonl"`
+	// BatchSize количество событий, забираемых из outbox за один запрос.
+	BatchSize int32 `envconfig:"OUTBOX_BATCH_SIZE" default:"100"`
+	// PollInterval пауза между опросами outbox, когда новых событий нет.
+	PollInterval time.Duration `envconfig:"OUTBOX_POLL_INTERVAL" default:"1s"`
+}
@@ -12,4 +12,9 @@ type Postgres struct {
 	MaxOpenConns    int           `envconfig:"POSTGRES_MAX_OPEN_CONNS" default:"10"`
 	MaxIdleConns    int           `envconfig:"POSTGRES_MAX_IDLE_CONNS" default:"7"`
 	ConnMaxLifetime time.Duration `envconfig:"POSTGRES_CONN_MAX_LIFETIME" default:"30m"`
+
+	// MigrationLockTimeout время ожидания advisory lock, которым сериализуются запуски миграций.
+	MigrationLockTimeout time.Duration `envconfig:"POSTGRES_MIGRATION_LOCK_TIMEOUT" default:"1m"`
+	// MigrateOnStart применять миграции перед запуском rest сервера.
+	MigrateOnStart bool `envconfig:"MIGRATE_ON_START" default:"false"`
 }
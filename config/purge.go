@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Purge struct {
+	// Retention сколько хранить мягко удаленных пользователей до физического удаления.
+	Retention time.Duration `envconfig:"PURGE_RETENTION" default:"720h"`
+	// BatchSize количество пользователей, удаляемых одним запросом.
+	BatchSize int32 `envconfig:"PURGE_BATCH_SIZE" default:"500"`
+}
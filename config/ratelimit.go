@@ -0,0 +1,14 @@
+package config
+
+type RateLimit struct {
+	Enabled bool `envconfig:"RATE_LIMIT_ENABLED" default:"true"`
+	// Backend memory - отдельный счет в каждом экземпляре, postgres - общий для всех экземпляров счет
+	// в таблице rate_limit_buckets ценой запроса к базе на каждый запрос к API.
+	Backend string `envconfig:"RATE_LIMIT_BACKEND" default:"memory"`
+	// Default лимит в формате <запросов>/<период>, например 300/1m, общий для операций без собственного лимита.
+	Default string `envconfig:"RATE_LIMIT_DEFAULT" default:"300/1m"`
+	// Operations собственные лимиты операций: <метод> <путь из спецификации>:<запросов>/<период> через запятую.
+	Operations map[string]string `envconfig:"RATE_LIMIT_OPERATIONS" default:"POST /auth/login:10/1m,POST /auth/register:5/1m,POST /auth/mfa/verify:10/1m,POST /auth/password/forgot:5/15m,POST /auth/verify-email/resend:5/15m"`
+	// Exempt операции без лимита, например пробы kubernetes.
+	Exempt []string `envconfig:"RATE_LIMIT_EXEMPT" default:"GET /health,GET /health/live,GET /health/ready"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Shutdown struct {
+	// Delay пауза между снятием readiness и закрытием listener, чтобы балансировщик успел исключить под.
+	Delay time.Duration `envconfig:"SHUTDOWN_DELAY" default:"5s"`
+	// Timeout время на завершение активных HTTP запросов.
+	Timeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"20s"`
+	// HookTimeout время на выполнение каждого зарегистрированного хука остановки.
+	HookTimeout time.Duration `envconfig:"SHUTDOWN_HOOK_TIMEOUT" default:"5s"`
+}
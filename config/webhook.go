@@ -0,0 +1,19 @@
+package config
+
+import "time"
+
+type Webhook struct {
+	// Timeout ограничение на один запрос к подписчику.
+	Timeout time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"5s"`
+	// MaxAttempts после стольких неудачных попыток доставка переходит в состояние dead.
+	MaxAttempts int32 `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	// BackoffBase пауза после первой неудачной попытки, каждая следующая вдвое длиннее.
+	BackoffBase time.Duration `envconfig:"WEBHOOK_BACKOFF_BASE" default:"30s"`
+	BackoffMax  time.Duration `envconfig:"WEBHOOK_BACKOFF_MAX" default:"1h"`
+	// BatchSize количество доставок, отправляемых параллельно.
+	BatchSize    int32         `envconfig:"WEBHOOK_BATCH_SIZE" default:"50"`
+	PollInterval time.Duration `envconfig:"WEBHOOK_POLL_INTERVAL" default:"1s"`
+	// AllowPrivateAddresses разрешает доставку на loopback, частные и link-local адреса,
+	// только для локальной разработки: иначе подписка открывает доступ к внутренней сети.
+	AllowPrivateAddresses bool `envconfig:"WEBHOOK_ALLOW_PRIVATE_ADDRESSES" default:"false"`
+}
@@ -0,0 +1,55 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Dir складывает письма в каталог файлами .eml вместо отправки. Используется для локальной разработки
+// и тестов: письма можно открыть почтовым клиентом или прочитать ссылку из файла.
+type Dir struct {
+	path string
+	from string
+}
+
+func NewDir(path, from string) *Dir {
+	return &Dir{path: path, from: from}
+}
+
+func (d *Dir) Send(_ context.Context, msg Message) error {
+	now := time.Now()
+
+	raw, err := compose(d.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(d.path, 0o755); err != nil { //nolint:mnd
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+
+	suffix := make([]byte, 4) //nolint:mnd
+	if _, err = rand.Read(suffix); err != nil {
+		return fmt.Errorf("generate file name: %w", err)
+	}
+
+	name := filepath.Join(d.path, now.UTC().Format("20060102T150405.000000000Z")+"-"+hex.EncodeToString(suffix)+".eml")
+
+	// письмо появляется в каталоге целиком: сначала пишется временный файл, затем переименовывается
+	tmp := name + ".tmp"
+
+	if err = os.WriteFile(tmp, raw, 0o644); err != nil { //nolint:mnd,gosec
+		return fmt.Errorf("write mail file: %w", err)
+	}
+
+	if err = os.Rename(tmp, name); err != nil {
+		return fmt.Errorf("rename mail file: %w", err)
+	}
+
+	return nil
+}
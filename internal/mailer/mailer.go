@@ -0,0 +1,83 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Message текстовое письмо одному получателю.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// Mailer отправляет письма. Send возвращает управление, когда письмо принято транспортом.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// compose собирает письмо в формате RFC 5322. Заголовки кодируются по RFC 2047, тело - quoted-printable.
+func compose(from string, msg Message, now time.Time) ([]byte, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("parse sender address: %w", err)
+	}
+
+	rcpt, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	id, err := messageID(sender.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", sender.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", rcpt.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", id)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+
+	if _, err = qp.Write([]byte(strings.ReplaceAll(msg.Text, "\n", "\r\n"))); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+
+	if err = qp.Close(); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func messageID(sender string) (string, error) {
+	buf := make([]byte, 16) //nolint:mnd
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate message id: %w", err)
+	}
+
+	domain := "localhost"
+	if _, after, ok := strings.Cut(sender, "@"); ok {
+		domain = after
+	}
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(buf), domain), nil
+}
@@ -0,0 +1,116 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+type SMTPOptions struct {
+	// Addr адрес сервера host:port.
+	Addr string
+	// Username и Password для AUTH PLAIN, без Username аутентификация не выполняется.
+	Username string
+	Password string
+	From     string
+	// Timeout ограничение на всю отправку одного письма.
+	Timeout time.Duration
+}
+
+// SMTP отправляет письма через SMTP сервер, переходя на TLS командой STARTTLS, если сервер ее поддерживает.
+type SMTP struct {
+	opts SMTPOptions
+}
+
+func NewSMTP(opts SMTPOptions) *SMTP {
+	return &SMTP{opts: opts}
+}
+
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	raw, err := compose(s.opts.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(s.opts.From)
+	if err != nil {
+		return fmt.Errorf("parse sender address: %w", err)
+	}
+
+	rcpt, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.Timeout)
+	defer cancel()
+
+	host, _, err := net.SplitHostPort(s.opts.Addr)
+	if err != nil {
+		return fmt.Errorf("parse smtp address: %w", err)
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.opts.Addr)
+	if err != nil {
+		return fmt.Errorf("dial smtp: %w", err)
+	}
+
+	defer conn.Close()
+
+	// net/smtp не принимает контекст, поэтому срок отправки ограничивается дедлайном соединения
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil { //nolint:exhaustruct
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+
+	if s.opts.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.opts.Username, s.opts.Password, host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err = client.Mail(sender.Address); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+
+	if err = client.Rcpt(rcpt.Address); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	if _, err = w.Write(raw); err != nil {
+		return fmt.Errorf("smtp write message: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("smtp send message: %w", err)
+	}
+
+	if err = client.Quit(); err != nil {
+		return fmt.Errorf("smtp quit: %w", err)
+	}
+
+	return nil
+}
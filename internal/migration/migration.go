@@ -1,6 +1,13 @@
 package migration
 
-import "embed"
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
 
 //go:embed *
 var FS embed.FS
@@ -8,3 +15,55 @@ var FS embed.FS
 const (
 	PostgresPath = "postgres"
 )
+
+// Migration встроенная миграция.
+type Migration struct {
+	Version uint
+	Name    string
+}
+
+// List возвращает встроенные миграции из каталога path в порядке применения.
+func List(path string) ([]Migration, error) {
+	src, err := iofs.New(FS, path)
+	if err != nil {
+		return nil, fmt.Errorf("open embedded migrations: %w", err)
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return nil, fmt.Errorf("first migration: %w", err)
+	}
+
+	var res []Migration
+
+	for {
+		r, name, err := src.ReadUp(version)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %d: %w", version, err)
+		}
+
+		_ = r.Close()
+
+		res = append(res, Migration{Version: version, Name: name})
+
+		version, err = src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return res, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("next migration after %d: %w", res[len(res)-1].Version, err)
+		}
+	}
+}
+
+// LatestVersion возвращает версию последней встроенной миграции из каталога path.
+func LatestVersion(path string) (uint, error) {
+	migrations, err := List(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return migrations[len(migrations)-1].Version, nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE idempotency_keys(
+    key                 VARCHAR(255) PRIMARY KEY    NOT NULL,
+    request_hash        TEXT                        NOT NULL,
+    user_guid           UUID                        NOT NULL REFERENCES users (guid),
+    created_at          TIMESTAMPTZ                 NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN idempotency_keys.key           IS 'Значение заголовка Idempotency-Key';
+COMMENT ON COLUMN idempotency_keys.request_hash  IS 'Хэш тела запроса, с которым был использован ключ';
+COMMENT ON COLUMN idempotency_keys.user_guid     IS 'GUID созданного пользователя';
+COMMENT ON COLUMN idempotency_keys.created_at    IS 'Дата создания';
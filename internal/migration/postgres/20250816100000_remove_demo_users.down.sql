@@ -0,0 +1,33 @@
+INSERT INTO users (
+        guid,
+        name,
+        occupation,
+        is_deleted,
+        created_at,
+        updated_at
+    )
+VALUES (
+        '149497f4-aaf0-4881-86c7-498d191d3717',
+        'Иванова Ариадна Евгеньевна',
+        'МУП ДЭС',
+        false,
+        now(),
+        now()
+    ),
+    (
+        'f531286c-7d8f-4fd0-9900-8da398e371b5',
+        'Степанов Эдуард',
+        'МУП ДЭС',
+        false,
+        now(),
+        now()
+    ),
+    (
+        '8bff61fe-c8a1-45c7-895a-b0907c390279',
+        'Сидоренко Валентин',
+        'МУП ДЭС',
+        false,
+        now(),
+        now()
+    )
+ON CONFLICT (guid) DO NOTHING;
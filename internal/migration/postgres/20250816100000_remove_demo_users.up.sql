@@ -0,0 +1,7 @@
+-- демо-пользователи перенесены в фикстуры команды seed
+DELETE FROM users
+WHERE guid IN (
+    '149497f4-aaf0-4881-86c7-498d191d3717',
+    'f531286c-7d8f-4fd0-9900-8da398e371b5',
+    '8bff61fe-c8a1-45c7-895a-b0907c390279'
+);
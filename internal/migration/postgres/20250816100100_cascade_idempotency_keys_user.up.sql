@@ -0,0 +1,3 @@
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_user_guid_fkey,
+    ADD CONSTRAINT idempotency_keys_user_guid_fkey FOREIGN KEY (user_guid) REFERENCES users (guid) ON DELETE CASCADE;
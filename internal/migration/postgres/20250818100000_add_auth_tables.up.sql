@@ -0,0 +1,31 @@
+CREATE TABLE credentials(
+    user_guid           UUID PRIMARY KEY        NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    login               VARCHAR(255) UNIQUE     NOT NULL,
+    password_hash       TEXT                    NOT NULL,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN credentials.user_guid       IS 'GUID пользователя';
+COMMENT ON COLUMN credentials.login           IS 'Логин в нижнем регистре';
+COMMENT ON COLUMN credentials.password_hash   IS 'bcrypt хэш пароля';
+COMMENT ON COLUMN credentials.created_at      IS 'Дата создания';
+COMMENT ON COLUMN credentials.updated_at      IS 'Дата обновления';
+
+CREATE TABLE refresh_tokens(
+    token_hash          TEXT PRIMARY KEY        NOT NULL,
+    family_id           UUID                    NOT NULL,
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    expires_at          TIMESTAMPTZ             NOT NULL,
+    revoked_at          TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+
+COMMENT ON COLUMN refresh_tokens.token_hash   IS 'SHA-256 хэш refresh token';
+COMMENT ON COLUMN refresh_tokens.family_id    IS 'Идентификатор сессии, общий для всей цепочки ротаций';
+COMMENT ON COLUMN refresh_tokens.user_guid    IS 'GUID пользователя';
+COMMENT ON COLUMN refresh_tokens.expires_at   IS 'Срок действия';
+COMMENT ON COLUMN refresh_tokens.revoked_at   IS 'Дата отзыва или использования';
+COMMENT ON COLUMN refresh_tokens.created_at   IS 'Дата создания';
@@ -0,0 +1,13 @@
+CREATE TABLE user_roles(
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    role                VARCHAR(32)             NOT NULL CHECK (role IN ('user', 'admin', 'service')),
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_guid, role)
+);
+
+COMMENT ON COLUMN user_roles.user_guid    IS 'GUID пользователя';
+COMMENT ON COLUMN user_roles.role         IS 'Роль: user, admin или service';
+COMMENT ON COLUMN user_roles.created_at   IS 'Дата назначения';
+
+INSERT INTO user_roles (user_guid, role)
+SELECT c.user_guid, 'user' FROM credentials c;
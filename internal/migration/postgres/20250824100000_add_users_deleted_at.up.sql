@@ -0,0 +1,10 @@
+ALTER TABLE users
+    ADD COLUMN deleted_at TIMESTAMPTZ NULL,
+    ADD COLUMN deleted_by UUID        NULL;
+
+UPDATE users SET deleted_at = updated_at WHERE is_deleted;
+
+CREATE INDEX users_deleted_at_idx ON users (deleted_at) WHERE is_deleted;
+
+COMMENT ON COLUMN users.deleted_at    IS 'Дата удаления';
+COMMENT ON COLUMN users.deleted_by    IS 'GUID пользователя, выполнившего удаление';
@@ -0,0 +1,21 @@
+CREATE TABLE user_audit(
+    id                  BIGSERIAL PRIMARY KEY   NOT NULL,
+    user_guid           UUID                    NOT NULL,
+    actor               UUID                    NULL,
+    action              VARCHAR(16)             NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore')),
+    before              JSONB                   NULL,
+    after               JSONB                   NULL,
+    request_id          VARCHAR(128)            NULL,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+CREATE INDEX user_audit_user_guid_idx ON user_audit (user_guid, id);
+
+COMMENT ON COLUMN user_audit.id           IS 'Порядковый номер записи';
+COMMENT ON COLUMN user_audit.user_guid    IS 'GUID измененного пользователя, без внешнего ключа, чтобы история переживала физическое удаление';
+COMMENT ON COLUMN user_audit.actor        IS 'GUID пользователя, выполнившего изменение, NULL для системных операций';
+COMMENT ON COLUMN user_audit.action       IS 'Тип изменения';
+COMMENT ON COLUMN user_audit.before       IS 'Состояние пользователя до изменения';
+COMMENT ON COLUMN user_audit.after        IS 'Состояние пользователя после изменения';
+COMMENT ON COLUMN user_audit.request_id   IS 'Идентификатор запроса из X-Request-Id';
+COMMENT ON COLUMN user_audit.created_at   IS 'Дата изменения';
@@ -0,0 +1,19 @@
+CREATE TABLE outbox(
+    id                  BIGSERIAL PRIMARY KEY   NOT NULL,
+    event_id            UUID UNIQUE             NOT NULL,
+    aggregate_id        UUID                    NOT NULL,
+    event_type          VARCHAR(64)             NOT NULL,
+    payload             JSONB                   NOT NULL,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    published_at        TIMESTAMPTZ             NULL
+);
+
+CREATE INDEX outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
+
+COMMENT ON COLUMN outbox.id           IS 'Порядковый номер события, определяет порядок публикации';
+COMMENT ON COLUMN outbox.event_id     IS 'Идентификатор события для дедупликации у получателей';
+COMMENT ON COLUMN outbox.aggregate_id IS 'GUID пользователя, к которому относится событие';
+COMMENT ON COLUMN outbox.event_type   IS 'Тип события, например user.created';
+COMMENT ON COLUMN outbox.payload      IS 'Тело события в JSON';
+COMMENT ON COLUMN outbox.created_at   IS 'Дата создания';
+COMMENT ON COLUMN outbox.published_at IS 'Дата публикации, NULL для неопубликованных';
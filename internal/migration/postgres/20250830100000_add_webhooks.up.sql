@@ -0,0 +1,68 @@
+CREATE TABLE webhooks(
+    id                  UUID PRIMARY KEY        NOT NULL,
+    owner_id            UUID                    NOT NULL,
+    url                 VARCHAR(2048)           NOT NULL,
+    event_types         TEXT[]                  NOT NULL DEFAULT '{}',
+    secret              VARCHAR(255)            NOT NULL,
+    active              BOOLEAN                 NOT NULL DEFAULT true,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhooks_owner_id_idx ON webhooks (owner_id);
+
+COMMENT ON COLUMN webhooks.id          IS 'Идентификатор подписки';
+COMMENT ON COLUMN webhooks.owner_id    IS 'GUID пользователя, создавшего подписку';
+COMMENT ON COLUMN webhooks.url         IS 'Адрес, на который отправляются события';
+COMMENT ON COLUMN webhooks.event_types IS 'Типы событий, пустой массив - все события';
+COMMENT ON COLUMN webhooks.secret      IS 'Ключ подписи HMAC-SHA256';
+COMMENT ON COLUMN webhooks.active      IS 'Признак активной подписки, неактивным события не отправляются';
+COMMENT ON COLUMN webhooks.created_at  IS 'Дата создания';
+COMMENT ON COLUMN webhooks.updated_at  IS 'Дата обновления';
+
+CREATE TABLE webhook_deliveries(
+    id                  BIGSERIAL PRIMARY KEY   NOT NULL,
+    webhook_id          UUID                    NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id            UUID                    NOT NULL,
+    event_type          VARCHAR(64)             NOT NULL,
+    payload             JSONB                   NOT NULL,
+    status              VARCHAR(16)             NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts            INTEGER                 NOT NULL DEFAULT 0,
+    next_attempt_at     TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    last_error          TEXT                    NULL,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    delivered_at        TIMESTAMPTZ             NULL,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+COMMENT ON COLUMN webhook_deliveries.id              IS 'Порядковый номер доставки';
+COMMENT ON COLUMN webhook_deliveries.webhook_id      IS 'Подписка, которой доставляется событие';
+COMMENT ON COLUMN webhook_deliveries.event_id        IS 'Идентификатор события из outbox';
+COMMENT ON COLUMN webhook_deliveries.event_type      IS 'Тип события, например user.created';
+COMMENT ON COLUMN webhook_deliveries.payload         IS 'Тело события в JSON';
+COMMENT ON COLUMN webhook_deliveries.status          IS 'Состояние доставки: pending, delivered или dead после исчерпания попыток';
+COMMENT ON COLUMN webhook_deliveries.attempts        IS 'Количество выполненных попыток';
+COMMENT ON COLUMN webhook_deliveries.next_attempt_at IS 'Время следующей попытки';
+COMMENT ON COLUMN webhook_deliveries.last_error      IS 'Ошибка последней попытки';
+COMMENT ON COLUMN webhook_deliveries.created_at      IS 'Дата создания';
+COMMENT ON COLUMN webhook_deliveries.delivered_at    IS 'Дата успешной доставки';
+
+CREATE TABLE webhook_attempts(
+    id                  BIGSERIAL PRIMARY KEY   NOT NULL,
+    delivery_id         BIGINT                  NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    status_code         INTEGER                 NULL,
+    error               TEXT                    NULL,
+    duration_ms         BIGINT                  NOT NULL,
+    attempted_at        TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhook_attempts_delivery_id_idx ON webhook_attempts (delivery_id, id);
+
+COMMENT ON COLUMN webhook_attempts.id           IS 'Порядковый номер попытки';
+COMMENT ON COLUMN webhook_attempts.delivery_id  IS 'Доставка, к которой относится попытка';
+COMMENT ON COLUMN webhook_attempts.status_code  IS 'HTTP статус ответа, NULL если ответ не получен';
+COMMENT ON COLUMN webhook_attempts.error        IS 'Ошибка попытки, NULL для успешной';
+COMMENT ON COLUMN webhook_attempts.duration_ms  IS 'Длительность запроса в миллисекундах';
+COMMENT ON COLUMN webhook_attempts.attempted_at IS 'Дата попытки';
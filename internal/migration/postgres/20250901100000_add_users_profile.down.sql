@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS users_username_key;
+DROP INDEX IF EXISTS users_email_key;
+
+ALTER TABLE users
+    DROP CONSTRAINT users_phone_e164_check,
+    DROP COLUMN username,
+    DROP COLUMN email,
+    DROP COLUMN phone,
+    DROP COLUMN first_name,
+    DROP COLUMN last_name,
+    DROP COLUMN birth_date;
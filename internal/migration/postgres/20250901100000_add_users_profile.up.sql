@@ -0,0 +1,20 @@
+ALTER TABLE users
+    ADD COLUMN username   VARCHAR(64)  NULL,
+    ADD COLUMN email      VARCHAR(255) NULL,
+    ADD COLUMN phone      VARCHAR(16)  NULL,
+    ADD COLUMN first_name VARCHAR(255) NULL,
+    ADD COLUMN last_name  VARCHAR(255) NULL,
+    ADD COLUMN birth_date DATE         NULL,
+    ADD CONSTRAINT users_phone_e164_check CHECK (phone ~ '^\+[1-9][0-9]{1,14}$');
+
+-- уникальность без учета регистра, удаленные пользователи тоже занимают email и имя пользователя,
+-- чтобы их можно было восстановить
+CREATE UNIQUE INDEX users_email_key ON users (lower(email));
+CREATE UNIQUE INDEX users_username_key ON users (lower(username));
+
+COMMENT ON COLUMN users.username      IS 'Имя пользователя для входа и отображения, уникально без учета регистра';
+COMMENT ON COLUMN users.email         IS 'Адрес электронной почты, уникален без учета регистра';
+COMMENT ON COLUMN users.phone         IS 'Телефон в формате E.164';
+COMMENT ON COLUMN users.first_name    IS 'Имя';
+COMMENT ON COLUMN users.last_name     IS 'Фамилия';
+COMMENT ON COLUMN users.birth_date    IS 'Дата рождения';
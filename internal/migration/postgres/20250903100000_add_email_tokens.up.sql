@@ -0,0 +1,23 @@
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMPTZ NULL;
+
+COMMENT ON COLUMN users.email_verified_at IS 'Дата подтверждения текущего email, сбрасывается при его смене';
+
+CREATE TABLE email_tokens(
+    token_hash          TEXT PRIMARY KEY        NOT NULL,
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    purpose             VARCHAR(32)             NOT NULL CHECK (purpose IN ('verify_email', 'reset_password')),
+    email               VARCHAR(255)            NOT NULL,
+    expires_at          TIMESTAMPTZ             NOT NULL,
+    used_at             TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+CREATE INDEX email_tokens_user_guid_idx ON email_tokens (user_guid, purpose) WHERE used_at IS NULL;
+
+COMMENT ON COLUMN email_tokens.token_hash   IS 'SHA-256 хэш токена из письма';
+COMMENT ON COLUMN email_tokens.user_guid    IS 'GUID пользователя';
+COMMENT ON COLUMN email_tokens.purpose      IS 'Назначение: verify_email или reset_password';
+COMMENT ON COLUMN email_tokens.email        IS 'Адрес, на который отправлен токен';
+COMMENT ON COLUMN email_tokens.expires_at   IS 'Срок действия';
+COMMENT ON COLUMN email_tokens.used_at      IS 'Дата использования или замены новым токеном';
+COMMENT ON COLUMN email_tokens.created_at   IS 'Дата создания';
@@ -0,0 +1,35 @@
+CREATE TABLE sessions(
+    id                  UUID PRIMARY KEY        NOT NULL,
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    user_agent          VARCHAR(512)            NOT NULL DEFAULT '',
+    ip                  VARCHAR(45)             NOT NULL DEFAULT '',
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    last_seen_at        TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    expires_at          TIMESTAMPTZ             NOT NULL,
+    revoked_at          TIMESTAMPTZ
+);
+
+CREATE INDEX sessions_user_guid_idx ON sessions (user_guid, last_seen_at DESC) WHERE revoked_at IS NULL;
+
+COMMENT ON COLUMN sessions.id           IS 'Идентификатор сессии, совпадает с refresh_tokens.family_id';
+COMMENT ON COLUMN sessions.user_guid    IS 'GUID пользователя';
+COMMENT ON COLUMN sessions.user_agent   IS 'User-Agent клиента при входе';
+COMMENT ON COLUMN sessions.ip           IS 'IP адрес клиента при последнем обращении';
+COMMENT ON COLUMN sessions.created_at   IS 'Дата входа';
+COMMENT ON COLUMN sessions.last_seen_at IS 'Дата последнего обновления токенов';
+COMMENT ON COLUMN sessions.expires_at   IS 'Срок действия последнего refresh token';
+COMMENT ON COLUMN sessions.revoked_at   IS 'Дата отзыва или выхода';
+
+-- сессии для уже выданных refresh token, сведения об устройстве для них неизвестны
+INSERT INTO sessions (id, user_guid, created_at, last_seen_at, expires_at, revoked_at)
+SELECT family_id,
+       user_guid,
+       min(created_at),
+       max(created_at),
+       max(expires_at),
+       CASE WHEN bool_and(revoked_at IS NOT NULL) THEN max(revoked_at) END
+FROM refresh_tokens
+GROUP BY family_id, user_guid;
+
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT refresh_tokens_family_id_fkey FOREIGN KEY (family_id) REFERENCES sessions (id) ON DELETE CASCADE;
@@ -0,0 +1,17 @@
+CREATE TABLE user_identities(
+    provider            VARCHAR(64)             NOT NULL,
+    subject             VARCHAR(255)            NOT NULL,
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    email               VARCHAR(255),
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    last_login_at       TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, subject),
+    CONSTRAINT user_identities_user_guid_provider_key UNIQUE (user_guid, provider)
+);
+
+COMMENT ON COLUMN user_identities.provider      IS 'Имя OIDC провайдера из настроек';
+COMMENT ON COLUMN user_identities.subject       IS 'Идентификатор пользователя у провайдера (sub)';
+COMMENT ON COLUMN user_identities.user_guid     IS 'GUID пользователя';
+COMMENT ON COLUMN user_identities.email         IS 'Email из ID token при последнем входе';
+COMMENT ON COLUMN user_identities.created_at    IS 'Дата привязки';
+COMMENT ON COLUMN user_identities.last_login_at IS 'Дата последнего входа через провайдера';
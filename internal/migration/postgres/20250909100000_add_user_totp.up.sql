@@ -0,0 +1,30 @@
+CREATE TABLE user_totp(
+    user_guid           UUID PRIMARY KEY        NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    secret              VARCHAR(64)             NOT NULL,
+    confirmed_at        TIMESTAMPTZ,
+    last_used_step      BIGINT                  NOT NULL DEFAULT 0,
+    failed_attempts     INTEGER                 NOT NULL DEFAULT 0,
+    locked_until        TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN user_totp.user_guid       IS 'GUID пользователя';
+COMMENT ON COLUMN user_totp.secret          IS 'Секрет TOTP в base32';
+COMMENT ON COLUMN user_totp.confirmed_at    IS 'Дата подтверждения первым кодом, до нее второй фактор не запрашивается';
+COMMENT ON COLUMN user_totp.last_used_step  IS 'Последний принятый 30-секундный интервал, код из него повторно не принимается';
+COMMENT ON COLUMN user_totp.failed_attempts IS 'Неверных кодов подряд';
+COMMENT ON COLUMN user_totp.locked_until    IS 'До этой даты коды не проверяются после превышения числа попыток';
+COMMENT ON COLUMN user_totp.created_at      IS 'Дата выпуска секрета';
+
+CREATE TABLE user_recovery_codes(
+    user_guid           UUID                    NOT NULL REFERENCES users (guid) ON DELETE CASCADE,
+    code_hash           VARCHAR(64)             NOT NULL,
+    used_at             TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_guid, code_hash)
+);
+
+COMMENT ON COLUMN user_recovery_codes.user_guid  IS 'GUID пользователя';
+COMMENT ON COLUMN user_recovery_codes.code_hash  IS 'SHA-256 одноразового кода восстановления в hex';
+COMMENT ON COLUMN user_recovery_codes.used_at    IS 'Дата использования';
+COMMENT ON COLUMN user_recovery_codes.created_at IS 'Дата выпуска';
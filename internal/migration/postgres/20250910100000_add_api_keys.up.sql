@@ -0,0 +1,23 @@
+CREATE TABLE api_keys(
+    id                  UUID PRIMARY KEY        NOT NULL,
+    name                VARCHAR(255)            NOT NULL,
+    prefix              VARCHAR(16)             NOT NULL UNIQUE,
+    key_hash            VARCHAR(64)             NOT NULL,
+    scopes              TEXT[]                  NOT NULL DEFAULT '{}',
+    created_by          UUID,
+    created_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    expires_at          TIMESTAMPTZ,
+    last_used_at        TIMESTAMPTZ,
+    revoked_at          TIMESTAMPTZ
+);
+
+COMMENT ON COLUMN api_keys.id           IS 'Идентификатор ключа, субъект запросов с этим ключом';
+COMMENT ON COLUMN api_keys.name         IS 'Название ключа, например имя сервиса-клиента';
+COMMENT ON COLUMN api_keys.prefix       IS 'Открытое начало ключа, по нему ключ находится при проверке';
+COMMENT ON COLUMN api_keys.key_hash     IS 'SHA-256 ключа целиком в hex';
+COMMENT ON COLUMN api_keys.scopes       IS 'Разрешения ключа: users:read, users:write, admin';
+COMMENT ON COLUMN api_keys.created_by   IS 'Субъект, выпустивший ключ: GUID администратора или идентификатор ключа API';
+COMMENT ON COLUMN api_keys.created_at   IS 'Дата выпуска';
+COMMENT ON COLUMN api_keys.expires_at   IS 'Срок действия, пустой - бессрочный';
+COMMENT ON COLUMN api_keys.last_used_at IS 'Дата последнего использования с точностью до минуты';
+COMMENT ON COLUMN api_keys.revoked_at   IS 'Дата отзыва';
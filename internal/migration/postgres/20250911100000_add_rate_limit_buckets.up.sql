@@ -0,0 +1,14 @@
+-- состояние лимитов не нужно восстанавливать после сбоя, поэтому таблица не пишется в WAL
+CREATE UNLOGGED TABLE rate_limit_buckets(
+    key                 VARCHAR(255) PRIMARY KEY NOT NULL,
+    tokens              DOUBLE PRECISION        NOT NULL,
+    updated_at          TIMESTAMPTZ             NOT NULL DEFAULT now(),
+    expires_at          TIMESTAMPTZ             NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_expires_at_idx ON rate_limit_buckets (expires_at);
+
+COMMENT ON COLUMN rate_limit_buckets.key        IS 'Операция и клиент: пользователь, ключ API или IP адрес';
+COMMENT ON COLUMN rate_limit_buckets.tokens     IS 'Остаток токенов на момент updated_at';
+COMMENT ON COLUMN rate_limit_buckets.updated_at IS 'Дата последнего списания токена';
+COMMENT ON COLUMN rate_limit_buckets.expires_at IS 'Дата полного пополнения, после нее запись можно удалить';
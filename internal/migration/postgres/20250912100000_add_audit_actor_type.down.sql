@@ -0,0 +1,10 @@
+DELETE FROM user_audit WHERE action NOT IN ('create', 'update', 'delete', 'restore');
+
+ALTER TABLE user_audit
+    DROP COLUMN actor_type,
+    DROP COLUMN details,
+    DROP CONSTRAINT user_audit_action_check,
+    ADD CONSTRAINT user_audit_action_check CHECK (action IN ('create', 'update', 'delete', 'restore'));
+
+ALTER TABLE users
+    DROP COLUMN deleted_by_type;
@@ -0,0 +1,25 @@
+ALTER TABLE user_audit
+    ADD COLUMN actor_type VARCHAR(16) NULL CHECK (actor_type IN ('user', 'api_key')),
+    ADD COLUMN details    JSONB       NULL,
+    DROP CONSTRAINT user_audit_action_check,
+    ADD CONSTRAINT user_audit_action_check CHECK (action IN (
+        'create', 'update', 'delete', 'restore', 'verify_email', 'password_reset', 'role_grant', 'role_revoke'
+    ));
+
+ALTER TABLE users
+    ADD COLUMN deleted_by_type VARCHAR(16) NULL CHECK (deleted_by_type IN ('user', 'api_key'));
+
+-- идентификаторы ключей API не пересекаются с GUID пользователей, поэтому тип субъекта восстанавливается по api_keys
+UPDATE user_audit a
+SET actor_type = CASE WHEN EXISTS (SELECT 1 FROM api_keys k WHERE k.id = a.actor) THEN 'api_key' ELSE 'user' END
+WHERE actor IS NOT NULL;
+
+UPDATE users u
+SET deleted_by_type = CASE WHEN EXISTS (SELECT 1 FROM api_keys k WHERE k.id = u.deleted_by) THEN 'api_key' ELSE 'user' END
+WHERE deleted_by IS NOT NULL;
+
+COMMENT ON COLUMN user_audit.actor      IS 'GUID пользователя или идентификатор ключа API, выполнившего изменение, NULL для системных операций';
+COMMENT ON COLUMN user_audit.actor_type IS 'Тип субъекта изменения: user или api_key, NULL для системных операций';
+COMMENT ON COLUMN user_audit.details    IS 'Подробности изменения, не видные по состоянию пользователя, например выданная роль';
+COMMENT ON COLUMN users.deleted_by      IS 'GUID пользователя или идентификатор ключа API, выполнившего удаление';
+COMMENT ON COLUMN users.deleted_by_type IS 'Тип субъекта удаления: user или api_key';
@@ -0,0 +1,7 @@
+DELETE FROM user_audit WHERE action = 'purge';
+
+ALTER TABLE user_audit
+    DROP CONSTRAINT user_audit_action_check,
+    ADD CONSTRAINT user_audit_action_check CHECK (action IN (
+        'create', 'update', 'delete', 'restore', 'verify_email', 'password_reset', 'role_grant', 'role_revoke'
+    ));
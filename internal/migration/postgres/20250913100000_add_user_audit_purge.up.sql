@@ -0,0 +1,5 @@
+ALTER TABLE user_audit
+    DROP CONSTRAINT user_audit_action_check,
+    ADD CONSTRAINT user_audit_action_check CHECK (action IN (
+        'create', 'update', 'delete', 'restore', 'verify_email', 'password_reset', 'role_grant', 'role_revoke', 'purge'
+    ));
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idempotency_keys_expires_at_idx;
+
+-- без субъекта ключи разных клиентов могут совпасть, остается самый ранний
+DELETE FROM idempotency_keys k
+USING idempotency_keys other
+WHERE k.key = other.key AND (k.created_at, k.principal) > (other.created_at, other.principal);
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD PRIMARY KEY (key),
+    DROP COLUMN principal,
+    DROP COLUMN expires_at;
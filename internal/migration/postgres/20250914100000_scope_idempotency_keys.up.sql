@@ -0,0 +1,21 @@
+ALTER TABLE idempotency_keys
+    ADD COLUMN principal  UUID        NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    ADD COLUMN expires_at TIMESTAMPTZ NOT NULL DEFAULT now() + interval '24 hours';
+
+-- субъект уже использованного ключа - тот, кто создал пользователя, он записан в журнале изменений
+UPDATE idempotency_keys k
+SET principal = a.actor,
+    expires_at = k.created_at + interval '24 hours'
+FROM user_audit a
+WHERE a.user_guid = k.user_guid AND a.action = 'create' AND a.actor IS NOT NULL;
+
+ALTER TABLE idempotency_keys
+    ALTER COLUMN principal DROP DEFAULT,
+    ALTER COLUMN expires_at DROP DEFAULT,
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD PRIMARY KEY (principal, key);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+COMMENT ON COLUMN idempotency_keys.principal  IS 'GUID пользователя или идентификатор ключа API, использовавшего ключ; ключи разных субъектов независимы';
+COMMENT ON COLUMN idempotency_keys.expires_at IS 'Срок действия ключа, после него ключ можно использовать повторно';
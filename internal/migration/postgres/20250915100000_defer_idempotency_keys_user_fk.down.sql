@@ -0,0 +1,2 @@
+ALTER TABLE idempotency_keys
+    ALTER CONSTRAINT idempotency_keys_user_guid_fkey NOT DEFERRABLE;
@@ -0,0 +1,3 @@
+-- ключ занимается до вставки пользователя в той же транзакции, ссылка проверяется при фиксации
+ALTER TABLE idempotency_keys
+    ALTER CONSTRAINT idempotency_keys_user_guid_fkey DEFERRABLE INITIALLY DEFERRED;
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,8 +20,28 @@ import (
 // swagger:model Error
 type Error struct {
 
-	// цифровой код ошибки
-	// Example: 123
+	// цифровой код ошибки:
+	//   * 1 - внутренняя ошибка сервера
+	//   * 2 - некорректный запрос
+	//   * 3 - пользователь, подписка, сессия, ключ API или OIDC провайдер не найдены
+	//   * 4 - пользователь уже удален
+	//   * 5 - конфликт с текущим состоянием пользователя или доставки, email или имя пользователя уже заняты,
+	//     к пользователю уже привязан другой аккаунт OIDC провайдера
+	//   * 6 - ошибка валидации данных
+	//   * 7 - Idempotency-Key уже использован с другим телом запроса
+	//   * 8 - требуется аутентификация или неверные учетные данные
+	//   * 9 - версия пользователя не совпадает с If-Match
+	//   * 10 - не передан заголовок If-Match
+	//   * 11 - пользователь удален
+	//   * 12 - токен из письма недействителен, истек или уже использован
+	//   * 13 - заголовок X-CSRF-Token отсутствует или не совпадает с cookie csrf_token
+	//   * 14 - вход через OIDC провайдер не удался
+	//   * 15 - OIDC провайдер недоступен
+	//   * 16 - неверный код второго фактора
+	//   * 17 - превышено число попыток ввода кода второго фактора
+	//   * 18 - превышен лимит запросов
+	// Example: 3
+	// Enum: [1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18]
 	Code int64 `json:"code,omitempty"`
 
 	// текстовое описание ошибки
@@ -33,6 +54,10 @@ type Error struct {
 func (m *Error) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMessage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -43,6 +68,39 @@ func (m *Error) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var errorTypeCodePropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorTypeCodePropEnum = append(errorTypeCodePropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *Error) validateCodeEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, errorTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Error) validateCode(formats strfmt.Registry) error {
+	if swag.IsZero(m.Code) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCodeEnum("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Error) validateMessage(formats strfmt.Registry) error {
 
 	if err := validate.Required("message", "body", m.Message); err != nil {
@@ -19,6 +19,27 @@ import (
 // swagger:model UserCreateParams
 type UserCreateParams struct {
 
+	// Дата рождения
+	// Example: 1990-05-17
+	// Format: date
+	BirthDate *strfmt.Date `json:"birth_date,omitempty"`
+
+	// Адрес электронной почты, уникален без учета регистра
+	// Example: drozdoborod@example.com
+	// Max Length: 255
+	// Format: email
+	Email strfmt.Email `json:"email,omitempty"`
+
+	// Имя
+	// Example: Эдуард
+	// Max Length: 255
+	FirstName string `json:"first_name,omitempty"`
+
+	// Фамилия
+	// Example: Дроздобород
+	// Max Length: 255
+	LastName string `json:"last_name,omitempty"`
+
 	// Имя пользователя
 	// Example: Дроздобород Эдуард
 	// Required: true
@@ -28,12 +49,40 @@ type UserCreateParams struct {
 	// Example: МУП ДЭС
 	// Required: true
 	Occupation string `json:"occupation"`
+
+	// Телефон в формате E.164
+	// Example: +79991234567
+	// Pattern: ^\+[1-9][0-9]{1,14}$
+	Phone string `json:"phone,omitempty"`
+
+	// Имя пользователя для входа, уникально без учета регистра
+	// Example: drozdoborod
+	// Max Length: 64
+	// Min Length: 3
+	// Pattern: ^[A-Za-z0-9_.-]+$
+	Username string `json:"username,omitempty"`
 }
 
 // Validate validates this user create params
 func (m *UserCreateParams) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBirthDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFirstName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastName(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -42,12 +91,72 @@ func (m *UserCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePhone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsername(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *UserCreateParams) validateBirthDate(formats strfmt.Registry) error {
+	if swag.IsZero(m.BirthDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("birth_date", "body", "date", m.BirthDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserCreateParams) validateEmail(formats strfmt.Registry) error {
+	if swag.IsZero(m.Email) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("email", "body", string(m.Email), 255); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserCreateParams) validateFirstName(formats strfmt.Registry) error {
+	if swag.IsZero(m.FirstName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("first_name", "body", m.FirstName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserCreateParams) validateLastName(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("last_name", "body", m.LastName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *UserCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.RequiredString("name", "body", m.Name); err != nil {
@@ -66,6 +175,38 @@ func (m *UserCreateParams) validateOccupation(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *UserCreateParams) validatePhone(formats strfmt.Registry) error {
+	if swag.IsZero(m.Phone) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("phone", "body", m.Phone, `^\+[1-9][0-9]{1,14}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserCreateParams) validateUsername(formats strfmt.Registry) error {
+	if swag.IsZero(m.Username) { // not required
+		return nil
+	}
+
+	if err := validate.MinLength("username", "body", m.Username, 3); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("username", "body", m.Username, 64); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("username", "body", m.Username, `^[A-Za-z0-9_.-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this user create params based on context it is used
 func (m *UserCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +20,39 @@ import (
 // swagger:model UserData
 type UserData struct {
 
+	// Дата рождения
+	// Format: date
+	BirthDate *strfmt.Date `json:"birth_date,omitempty"`
+
+	// Дата создания
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at"`
+
+	// Дата удаления
+	// Format: date-time
+	DeletedAt *strfmt.DateTime `json:"deleted_at,omitempty"`
+
+	// GUID пользователя или идентификатор ключа API, выполнившего удаление
+	// Format: uuid
+	DeletedBy *strfmt.UUID `json:"deleted_by,omitempty"`
+
+	// Тип субъекта удаления
+	// Enum: [user api_key]
+	DeletedByType string `json:"deleted_by_type,omitempty"`
+
+	// Адрес электронной почты
+	// Example: drozdoborod@example.com
+	// Format: email
+	Email strfmt.Email `json:"email,omitempty"`
+
+	// Дата подтверждения email, отсутствует, если текущий email не подтвержден
+	// Format: date-time
+	EmailVerifiedAt *strfmt.DateTime `json:"email_verified_at,omitempty"`
+
+	// Имя
+	// Example: Эдуард
+	FirstName string `json:"first_name,omitempty"`
+
 	// guid
 	// Format: uuid
 	GUID strfmt.UUID `json:"guid"`
@@ -26,6 +60,10 @@ type UserData struct {
 	// Признак удален ли пользователь
 	IsDeleted bool `json:"is_deleted"`
 
+	// Фамилия
+	// Example: Дроздобород
+	LastName string `json:"last_name,omitempty"`
+
 	// Имя пользователя
 	// Example: Дроздобород Эдуард
 	// Required: true
@@ -35,12 +73,55 @@ type UserData struct {
 	// Example: МУП ДЭС
 	// Required: true
 	Occupation string `json:"occupation"`
+
+	// Телефон в формате E.164
+	// Example: +79991234567
+	Phone string `json:"phone,omitempty"`
+
+	// Дата обновления
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at"`
+
+	// Имя пользователя для входа
+	// Example: drozdoborod
+	Username string `json:"username,omitempty"`
+
+	// Версия пользователя, увеличивается при каждом изменении
+	Version int64 `json:"version"`
 }
 
 // Validate validates this user data
 func (m *UserData) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBirthDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeletedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeletedByType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmailVerifiedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateGUID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,12 +134,133 @@ func (m *UserData) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *UserData) validateBirthDate(formats strfmt.Registry) error {
+	if swag.IsZero(m.BirthDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("birth_date", "body", "date", m.BirthDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserData) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserData) validateDeletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleted_at", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserData) validateDeletedBy(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedBy) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleted_by", "body", "uuid", m.DeletedBy.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var userDataTypeDeletedByTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["user","api_key"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		userDataTypeDeletedByTypePropEnum = append(userDataTypeDeletedByTypePropEnum, v)
+	}
+}
+
+const (
+
+	// UserDataDeletedByTypeUser captures enum value "user"
+	UserDataDeletedByTypeUser string = "user"
+)
+
+const (
+
+	// UserDataDeletedByTypeAPIKey captures enum value "api_key"
+	UserDataDeletedByTypeAPIKey string = "api_key"
+)
+
+// prop value enum
+func (m *UserData) validateDeletedByTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, userDataTypeDeletedByTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *UserData) validateDeletedByType(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedByType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDeletedByTypeEnum("deleted_by_type", "body", m.DeletedByType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserData) validateEmail(formats strfmt.Registry) error {
+	if swag.IsZero(m.Email) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserData) validateEmailVerifiedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EmailVerifiedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("email_verified_at", "body", "date-time", m.EmailVerifiedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *UserData) validateGUID(formats strfmt.Registry) error {
 	if swag.IsZero(m.GUID) { // not required
 		return nil
@@ -89,6 +291,18 @@ func (m *UserData) validateOccupation(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *UserData) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this user data based on context it is used
 func (m *UserData) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
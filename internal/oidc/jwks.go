@@ -0,0 +1,209 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var errUnsupportedKey = errors.New("unsupported key")
+
+// minRefetchInterval ограничивает повторную загрузку JWKS из-за неизвестного kid,
+// чтобы токены с произвольным kid не превращались в поток запросов к провайдеру.
+const minRefetchInterval = 30 * time.Second
+
+// keySet кеш ключей подписи провайдера. Ключи перечитываются по истечении ttl
+// или когда токен подписан ключом, которого нет в кеше (ротация ключей у провайдера).
+type keySet struct {
+	client *http.Client
+	uri    string
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	// missedAt время последней загрузки из-за неизвестного kid
+	missedAt time.Time
+}
+
+func newKeySet(client *http.Client, uri string, ttl time.Duration) *keySet {
+	return &keySet{ //nolint:exhaustruct
+		client: client,
+		uri:    uri,
+		ttl:    ttl,
+	}
+}
+
+func (k *keySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keys != nil && time.Since(k.fetchedAt) < k.ttl {
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+
+		if time.Since(k.missedAt) < minRefetchInterval {
+			return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+		}
+
+		k.missedAt = time.Now()
+	}
+
+	if err := k.fetch(ctx); err != nil {
+		// при недоступности провайдера устаревшие ключи лучше отказа во входе
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+
+		return nil, err
+	}
+
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+}
+
+// lookup токен без kid допустим, только если у провайдера один ключ.
+func (k *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+
+	key, ok := k.keys[kid]
+
+	return key, ok
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k *keySet) fetch(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: jwks request: %w", ErrUnavailable, err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	status, err := doJSON(k.client, req, &set)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK {
+		return fmt.Errorf("%w: jwks status %d", ErrUnavailable, status)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+
+	for _, raw := range set.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+
+		// ключи неизвестных типов пропускаются, ими все равно нельзя проверить подпись
+		key, err := raw.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[raw.Kid] = key
+	}
+
+	k.keys = keys
+	k.fetchedAt = time.Now()
+
+	return nil
+}
+
+func (j jwk) publicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("rsa modulus: %w", err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("rsa exponent: %w", err)
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("%w: rsa exponent", errUnsupportedKey)
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		return j.ecPublicKey()
+	default:
+		return nil, fmt.Errorf("%w: kty %q", errUnsupportedKey, j.Kty)
+	}
+}
+
+func (j jwk) ecPublicKey() (crypto.PublicKey, error) {
+	var (
+		curve elliptic.Curve
+		check ecdh.Curve
+	)
+
+	switch j.Crv {
+	case "P-256":
+		curve, check = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, check = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, check = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("%w: crv %q", errUnsupportedKey, j.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(j.X)
+	if err != nil {
+		return nil, fmt.Errorf("ec x: %w", err)
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(j.Y)
+	if err != nil {
+		return nil, fmt.Errorf("ec y: %w", err)
+	}
+
+	size := (curve.Params().BitSize + 7) / 8 //nolint:mnd
+	if len(x) != size || len(y) != size {
+		return nil, fmt.Errorf("%w: ec coordinate size", errUnsupportedKey)
+	}
+
+	// ecdh проверяет, что точка лежит на кривой
+	point := append(append([]byte{4}, x...), y...) //nolint:mnd
+	if _, err = check.NewPublicKey(point); err != nil {
+		return nil, fmt.Errorf("ec point: %w", err)
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+}
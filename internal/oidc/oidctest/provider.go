@@ -0,0 +1,396 @@
+// Package oidctest OIDC провайдер в памяти, с которым весь вход через SSO работает без сети:
+// в тестах и при локальной разработке (команда fake-idp).
+//
+// Провайдер подтверждает любой вход без формы логина. Пользователь выбирается параметром login_hint,
+// по умолчанию DefaultLogin. Код авторизации одноразовый, требует PKCE S256 и тот же redirect_uri.
+// Секрет клиента не проверяется.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultLogin пользователь, если login_hint не передан.
+const DefaultLogin = "user"
+
+const (
+	codeTTL    = time.Minute
+	idTokenTTL = 5 * time.Minute
+	rsaBits    = 2048
+)
+
+// User сведения о пользователе, попадающие в ID token.
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type signingKey struct {
+	id  string
+	key *rsa.PrivateKey
+}
+
+type grant struct {
+	clientID    string
+	redirectURI string
+	challenge   string
+	nonce       string
+	user        User
+	expiresAt   time.Time
+}
+
+type Provider struct {
+	issuer string
+	prefix string
+	server *httptest.Server
+
+	mu    sync.Mutex
+	keys  []signingKey
+	users map[string]User
+	codes map[string]grant
+}
+
+// New провайдер с адресом issuer. Путь issuer становится префиксом всех его адресов.
+func New(issuer string) (*Provider, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return nil, fmt.Errorf("parse issuer: %w", err)
+	}
+
+	p := &Provider{ //nolint:exhaustruct
+		issuer: issuer,
+		prefix: strings.TrimSuffix(u.Path, "/"),
+		users:  make(map[string]User),
+		codes:  make(map[string]grant),
+	}
+
+	if err = p.RotateKey(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// NewServer запускает провайдера на случайном локальном порту, остановить - Close.
+func NewServer() (*Provider, error) {
+	server := httptest.NewUnstartedServer(nil)
+
+	p, err := New("http://" + server.Listener.Addr().String())
+	if err != nil {
+		server.Close()
+
+		return nil, err
+	}
+
+	p.server = server
+	server.Config.Handler = p
+	server.Start()
+
+	return p, nil
+}
+
+func (p *Provider) Close() {
+	if p.server != nil {
+		p.server.Close()
+	}
+}
+
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// SetUser задает сведения о пользователе для login_hint login.
+func (p *Provider) SetUser(login string, user User) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.users[login] = user
+}
+
+// RotateKey начинает подписывать токены новым ключом. Прежние ключи остаются в JWKS,
+// как при плановой ротации у настоящего провайдера.
+func (p *Provider) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	if err != nil {
+		return fmt.Errorf("generate signing key: %w", err)
+	}
+
+	id, err := randomString()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keys = append(p.keys, signingKey{id: id, key: key})
+
+	return nil
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, p.prefix) {
+	case "/.well-known/openid-configuration":
+		p.discovery(w)
+	case "/authorize":
+		p.authorize(w, r)
+	case "/token":
+		p.token(w, r)
+	case "/jwks":
+		p.jwks(w)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (p *Provider) discovery(w http.ResponseWriter) {
+	base := strings.TrimSuffix(p.issuer, "/")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                base + "/authorize",
+		"token_endpoint":                        base + "/token",
+		"jwks_uri":                              base + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"scopes_supported":                      []string{"openid", "email", "profile"},
+	})
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || !redirect.IsAbs() || q.Get("client_id") == "" {
+		// без корректного redirect_uri ошибку можно показать только на странице провайдера
+		http.Error(w, "invalid client_id or redirect_uri", http.StatusBadRequest)
+
+		return
+	}
+
+	reply := redirect.Query()
+	if state := q.Get("state"); state != "" {
+		reply.Set("state", state)
+	}
+
+	switch {
+	case q.Get("response_type") != "code":
+		reply.Set("error", "unsupported_response_type")
+	case !hasScope(q.Get("scope"), "openid"):
+		reply.Set("error", "invalid_scope")
+	case q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "":
+		reply.Set("error", "invalid_request")
+		reply.Set("error_description", "PKCE S256 is required")
+	default:
+		code, err := p.issueCode(q)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		reply.Set("code", code)
+	}
+
+	redirect.RawQuery = reply.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *Provider) issueCode(q url.Values) (string, error) {
+	code, err := randomString()
+	if err != nil {
+		return "", err
+	}
+
+	login := q.Get("login_hint")
+	if login == "" {
+		login = DefaultLogin
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.codes[code] = grant{
+		clientID:    q.Get("client_id"),
+		redirectURI: q.Get("redirect_uri"),
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		user:        p.user(login),
+		expiresAt:   time.Now().Add(codeTTL),
+	}
+
+	return code, nil
+}
+
+// user пользователь, заданный через SetUser, или сведения, выведенные из логина.
+func (p *Provider) user(login string) User {
+	if user, ok := p.users[login]; ok {
+		return user
+	}
+
+	email := login
+	if !strings.Contains(login, "@") {
+		email = login + "@example.com"
+	}
+
+	return User{Subject: login, Email: email, EmailVerified: true, Name: login}
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request", err.Error())
+
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type", "")
+
+		return
+	}
+
+	clientID := r.PostForm.Get("client_id")
+	if id, _, ok := r.BasicAuth(); ok {
+		clientID, _ = url.QueryUnescape(id)
+	}
+
+	p.mu.Lock()
+	g, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	challenge := base64.RawURLEncoding.EncodeToString(verifier[:])
+
+	switch {
+	case !ok || time.Now().After(g.expiresAt):
+		tokenError(w, "invalid_grant", "unknown, expired or used code")
+	case g.clientID != clientID || g.redirectURI != r.PostForm.Get("redirect_uri"):
+		tokenError(w, "invalid_grant", "client_id or redirect_uri mismatch")
+	case subtle.ConstantTimeCompare([]byte(challenge), []byte(g.challenge)) != 1:
+		tokenError(w, "invalid_grant", "PKCE verification failed")
+	default:
+		p.issueTokens(w, g)
+	}
+}
+
+func (p *Provider) issueTokens(w http.ResponseWriter, g grant) {
+	now := time.Now()
+
+	p.mu.Lock()
+	signer := p.keys[len(p.keys)-1]
+	p.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                p.issuer,
+		"sub":                g.user.Subject,
+		"aud":                g.clientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(idTokenTTL).Unix(),
+		"nonce":              g.nonce,
+		"email":              g.user.Email,
+		"email_verified":     g.user.EmailVerified,
+		"name":               g.user.Name,
+		"preferred_username": g.user.Subject,
+	})
+	token.Header["kid"] = signer.id
+
+	idToken, err := token.SignedString(signer.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	access, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": access,
+		"token_type":   "Bearer",
+		"expires_in":   int(idTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := make([]map[string]string, 0, len(p.keys))
+
+	for _, k := range p.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"kid": k.id,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(k.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.E)).Bytes()),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"keys": keys})
+}
+
+func tokenError(w http.ResponseWriter, code, description string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code, "error_description": description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func hasScope(scope, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+
+	return false
+}
+
+func randomString() (string, error) {
+	buf := make([]byte, 16) //nolint:mnd
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate random value: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,333 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrUnavailable провайдер недоступен или отвечает не по протоколу.
+	ErrUnavailable = errors.New("oidc provider unavailable")
+	// ErrExchange провайдер отклонил код авторизации.
+	ErrExchange       = errors.New("authorization code exchange failed")
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+// maxResponseBody ограничение на размер ответов провайдера.
+const maxResponseBody = 1 << 20
+
+// clockSkew допустимое расхождение часов с провайдером при проверке сроков ID token.
+const clockSkew = time.Minute
+
+// signingMethods алгоритмы подписи ID token с открытым ключом из JWKS. HS* и none не принимаются.
+var signingMethods = []string{ //nolint:gochecknoglobals
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+}
+
+type Config struct {
+	Issuer   string
+	ClientID string
+	// ClientSecret пустой для публичного клиента, защищенного только PKCE.
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// JWKSCacheTTL как долго используются загруженные ключи подписи провайдера.
+	JWKSCacheTTL time.Duration
+}
+
+// Identity проверенные сведения о пользователе из ID token.
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// Flow одноразовые значения одной попытки входа. Хранятся у клиента до возврата от провайдера.
+type Flow struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+func NewFlow() (Flow, error) {
+	var (
+		flow Flow
+		err  error
+	)
+
+	for _, v := range []*string{&flow.State, &flow.Nonce, &flow.Verifier} {
+		if *v, err = randomString(); err != nil {
+			return flow, err
+		}
+	}
+
+	return flow, nil
+}
+
+// Provider клиент authorization code flow с PKCE для одного провайдера.
+// Метаданные провайдера загружаются при первом обращении.
+type Provider struct {
+	conf   Config
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *metadata
+	keys *keySet
+}
+
+func NewProvider(client *http.Client, conf Config) *Provider {
+	return &Provider{ //nolint:exhaustruct
+		conf:   conf,
+		client: client,
+	}
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// AuthCodeURL адрес страницы входа провайдера. loginHint необязательная подсказка логина.
+func (p *Provider) AuthCodeURL(ctx context.Context, flow Flow, loginHint string) (string, error) {
+	meta, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: authorization endpoint: %w", ErrUnavailable, err)
+	}
+
+	challenge := sha256.Sum256([]byte(flow.Verifier))
+
+	query := endpoint.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.conf.ClientID)
+	query.Set("redirect_uri", p.conf.RedirectURL)
+	query.Set("scope", strings.Join(p.conf.Scopes, " "))
+	query.Set("state", flow.State)
+	query.Set("nonce", flow.Nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+
+	if loginHint != "" {
+		query.Set("login_hint", loginHint)
+	}
+
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint.String(), nil
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange обменивает код авторизации на токены провайдера и проверяет ID token попытки flow.
+func (p *Provider) Exchange(ctx context.Context, code string, flow Flow) (*Identity, error) {
+	meta, keys, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectURL},
+		"code_verifier": {flow.Verifier},
+		"client_id":     {p.conf.ClientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: token request: %w", ErrUnavailable, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if p.conf.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.conf.ClientID), url.QueryEscape(p.conf.ClientSecret))
+	}
+
+	var token tokenResponse
+
+	status, err := doJSON(p.client, req, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case status >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("%w: token endpoint status %d", ErrUnavailable, status)
+	case status != http.StatusOK:
+		return nil, fmt.Errorf("%w: %s: %s", ErrExchange, token.Error, token.ErrorDescription)
+	case token.IDToken == "":
+		return nil, fmt.Errorf("%w: no id_token in response", ErrExchange)
+	}
+
+	return p.verify(ctx, meta, keys, token.IDToken, flow.Nonce)
+}
+
+type idClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string   `json:"nonce"`
+	AuthorizedParty   string   `json:"azp"`
+	Email             string   `json:"email"`
+	EmailVerified     flexBool `json:"email_verified"`
+	Name              string   `json:"name"`
+	PreferredUsername string   `json:"preferred_username"`
+}
+
+func (p *Provider) verify(ctx context.Context, meta *metadata, keys *keySet, raw, nonce string) (*Identity, error) {
+	var c idClaims
+
+	_, err := jwt.ParseWithClaims(raw, &c, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		return keys.key(ctx, kid)
+	},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.conf.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+
+	// azp обязателен для токена с несколькими получателями и должен указывать на нас
+	if (len(c.Audience) > 1 || c.AuthorizedParty != "") && c.AuthorizedParty != p.conf.ClientID {
+		return nil, fmt.Errorf("%w: authorized party %q", ErrInvalidIDToken, c.AuthorizedParty)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(c.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return &Identity{
+		Subject:           c.Subject,
+		Email:             c.Email,
+		EmailVerified:     bool(c.EmailVerified),
+		Name:              c.Name,
+		PreferredUsername: c.PreferredUsername,
+	}, nil
+}
+
+// discover загружает метаданные провайдера. Успешный результат кешируется до перезапуска,
+// ключи подписи кешируются отдельно в keySet.
+func (p *Provider) discover(ctx context.Context) (*metadata, *keySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, p.keys, nil
+	}
+
+	endpoint := strings.TrimSuffix(p.conf.Issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: discovery request: %w", ErrUnavailable, err)
+	}
+
+	var meta metadata
+
+	status, err := doJSON(p.client, req, &meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status != http.StatusOK {
+		return nil, nil, fmt.Errorf("%w: discovery status %d", ErrUnavailable, status)
+	}
+
+	// метаданные другого провайдера позволили бы подменить ключи подписи
+	if meta.Issuer != p.conf.Issuer {
+		return nil, nil, fmt.Errorf("%w: discovery issuer %q does not match %q", ErrUnavailable, meta.Issuer, p.conf.Issuer)
+	}
+
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, nil, fmt.Errorf("%w: incomplete discovery document", ErrUnavailable)
+	}
+
+	p.meta = &meta
+	p.keys = newKeySet(p.client, meta.JWKSURI, p.conf.JWKSCacheTTL)
+
+	return p.meta, p.keys, nil
+}
+
+// doJSON выполняет запрос и разбирает JSON ответа с любым статусом. Тело ответа не в JSON считается ошибкой
+// только для успешного статуса.
+func doJSON(client *http.Client, req *http.Request, dst interface{}) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return 0, fmt.Errorf("%w: read response: %w", ErrUnavailable, err)
+	}
+
+	if err = json.Unmarshal(body, dst); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("%w: decode response: %w", ErrUnavailable, err)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// flexBool email_verified, который часть провайдеров отдает строкой "true".
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case `true`, `"true"`:
+		*b = true
+	default:
+		*b = false
+	}
+
+	return nil
+}
+
+func randomString() (string, error) {
+	buf := make([]byte, 32) //nolint:mnd
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate random value: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,244 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"otusgruz/internal/oidc"
+	"otusgruz/internal/oidc/oidctest"
+)
+
+const (
+	clientID    = "otusgruz"
+	redirectURL = "https://app.example.com/api/auth/oidc/corp/callback"
+)
+
+// countingTransport считает запросы JWKS и может отвечать на них 503, как недоступный провайдер.
+type countingTransport struct {
+	jwks     atomic.Int32
+	jwksDown atomic.Bool
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/jwks") {
+		c.jwks.Add(1)
+
+		if c.jwksDown.Load() {
+			return &http.Response{ //nolint:exhaustruct
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       io.NopCloser(strings.NewReader("{}")),
+				Request:    req,
+			}, nil
+		}
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newIDP(t *testing.T) *oidctest.Provider {
+	t.Helper()
+
+	idp, err := oidctest.NewServer()
+	if err != nil {
+		t.Fatalf("start provider: %v", err)
+	}
+
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+func newProvider(idp *oidctest.Provider, transport http.RoundTripper, jwksTTL time.Duration) *oidc.Provider {
+	return oidc.NewProvider(&http.Client{Transport: transport}, oidc.Config{ //nolint:exhaustruct
+		Issuer:       idp.Issuer(),
+		ClientID:     clientID,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		JWKSCacheTTL: jwksTTL,
+	})
+}
+
+// authorize проходит страницу входа провайдера и возвращает параметры возврата на redirect_uri.
+func authorize(t *testing.T, p *oidc.Provider, flow oidc.Flow, loginHint string) url.Values {
+	t.Helper()
+
+	authURL, err := p.AuthCodeURL(context.Background(), flow, loginHint)
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+
+	browser := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { //nolint:exhaustruct
+		return http.ErrUseLastResponse
+	}}
+
+	resp, err := browser.Get(authURL) //nolint:noctx
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	defer resp.Body.Close()
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d, location %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+
+	if got := location.Scheme + "://" + location.Host + location.Path; got != redirectURL {
+		t.Fatalf("redirected to %s, want %s", got, redirectURL)
+	}
+
+	return location.Query()
+}
+
+func newFlow(t *testing.T) oidc.Flow {
+	t.Helper()
+
+	flow, err := oidc.NewFlow()
+	if err != nil {
+		t.Fatalf("new flow: %v", err)
+	}
+
+	return flow
+}
+
+func TestExchange(t *testing.T) {
+	idp := newIDP(t)
+	idp.SetUser("alice", oidctest.User{Subject: "alice-sub", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+
+	p := newProvider(idp, http.DefaultTransport, time.Hour)
+	flow := newFlow(t)
+
+	reply := authorize(t, p, flow, "alice")
+	if reply.Get("state") != flow.State {
+		t.Errorf("state %q, want %q", reply.Get("state"), flow.State)
+	}
+
+	identity, err := p.Exchange(context.Background(), reply.Get("code"), flow)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+
+	want := oidc.Identity{
+		Subject:           "alice-sub",
+		Email:             "alice@example.com",
+		EmailVerified:     true,
+		Name:              "Alice",
+		PreferredUsername: "alice-sub",
+	}
+	if *identity != want {
+		t.Errorf("identity %+v, want %+v", *identity, want)
+	}
+
+	// код одноразовый
+	if _, err = p.Exchange(context.Background(), reply.Get("code"), flow); !errors.Is(err, oidc.ErrExchange) {
+		t.Errorf("reused code: error %v, want %v", err, oidc.ErrExchange)
+	}
+}
+
+func TestExchangeRejectsForeignFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(flow *oidc.Flow)
+		want error
+	}{
+		{
+			name: "pkce verifier mismatch",
+			edit: func(flow *oidc.Flow) { flow.Verifier = newFlow(t).Verifier },
+			want: oidc.ErrExchange,
+		},
+		{
+			name: "nonce mismatch",
+			edit: func(flow *oidc.Flow) { flow.Nonce = newFlow(t).Nonce },
+			want: oidc.ErrInvalidIDToken,
+		},
+	}
+
+	idp := newIDP(t)
+	p := newProvider(idp, http.DefaultTransport, time.Hour)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flow := newFlow(t)
+			code := authorize(t, p, flow, "").Get("code")
+
+			tt.edit(&flow)
+
+			if _, err := p.Exchange(context.Background(), code, flow); !errors.Is(err, tt.want) {
+				t.Errorf("error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWKSCache(t *testing.T) {
+	login := func(t *testing.T, p *oidc.Provider) {
+		t.Helper()
+
+		flow := newFlow(t)
+
+		if _, err := p.Exchange(context.Background(), authorize(t, p, flow, "").Get("code"), flow); err != nil {
+			t.Fatalf("exchange: %v", err)
+		}
+	}
+
+	t.Run("reused until ttl", func(t *testing.T) {
+		idp := newIDP(t)
+		transport := &countingTransport{} //nolint:exhaustruct
+		p := newProvider(idp, transport, 100*time.Millisecond)
+
+		login(t, p)
+		login(t, p)
+
+		if got := transport.jwks.Load(); got != 1 {
+			t.Errorf("%d jwks requests within ttl, want 1", got)
+		}
+
+		time.Sleep(150 * time.Millisecond)
+		login(t, p)
+
+		if got := transport.jwks.Load(); got != 2 {
+			t.Errorf("%d jwks requests after ttl, want 2", got)
+		}
+	})
+
+	t.Run("refetched for unknown key", func(t *testing.T) {
+		idp := newIDP(t)
+		transport := &countingTransport{} //nolint:exhaustruct
+		p := newProvider(idp, transport, time.Hour)
+
+		login(t, p)
+
+		if err := idp.RotateKey(); err != nil {
+			t.Fatalf("rotate key: %v", err)
+		}
+
+		login(t, p)
+
+		if got := transport.jwks.Load(); got != 2 {
+			t.Errorf("%d jwks requests after key rotation, want 2", got)
+		}
+	})
+
+	t.Run("stale keys when jwks is unavailable", func(t *testing.T) {
+		idp := newIDP(t)
+		transport := &countingTransport{} //nolint:exhaustruct
+		p := newProvider(idp, transport, 50*time.Millisecond)
+
+		login(t, p)
+
+		transport.jwksDown.Store(true)
+		time.Sleep(100 * time.Millisecond)
+
+		login(t, p)
+
+		if got := transport.jwks.Load(); got != 2 {
+			t.Errorf("%d jwks requests, want 2", got)
+		}
+	})
+}
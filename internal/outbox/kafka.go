@@ -0,0 +1,94 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+var ErrUnknownAcks = errors.New("unknown kafka acks")
+
+// Заголовки записи Kafka с метаданными события.
+const (
+	HeaderEventID   = "event-id"
+	HeaderEventType = "event-type"
+)
+
+type KafkaOptions struct {
+	Brokers        []string
+	ClientID       string
+	Topic          string
+	Acks           string
+	Linger         time.Duration
+	BatchMaxBytes  int32
+	Retries        int
+	ProduceTimeout time.Duration
+}
+
+// KafkaPublisher публикует события в топик Kafka. Ключ записи - GUID пользователя,
+// поэтому события одного пользователя попадают в одну партицию и читаются по порядку.
+type KafkaPublisher struct {
+	client *kgo.Client
+}
+
+func NewKafkaPublisher(opts KafkaOptions) (*KafkaPublisher, error) {
+	kopts := []kgo.Opt{
+		kgo.SeedBrokers(opts.Brokers...),
+		kgo.ClientID(opts.ClientID),
+		kgo.DefaultProduceTopic(opts.Topic),
+		kgo.ProducerLinger(opts.Linger),
+		kgo.ProducerBatchMaxBytes(opts.BatchMaxBytes),
+		kgo.RecordRetries(opts.Retries),
+		kgo.ProduceRequestTimeout(opts.ProduceTimeout),
+	}
+
+	switch opts.Acks {
+	case "all":
+		kopts = append(kopts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	case "leader":
+		kopts = append(kopts, kgo.RequiredAcks(kgo.LeaderAck()), kgo.DisableIdempotentWrite())
+	case "none":
+		kopts = append(kopts, kgo.RequiredAcks(kgo.NoAck()), kgo.DisableIdempotentWrite())
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownAcks, opts.Acks)
+	}
+
+	client, err := kgo.NewClient(kopts...)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client: %w", err)
+	}
+
+	return &KafkaPublisher{client: client}, nil
+}
+
+// Publish ждет подтверждения всех записей пачки.
+func (p *KafkaPublisher) Publish(ctx context.Context, msgs []Message) error {
+	records := make([]*kgo.Record, 0, len(msgs))
+
+	for _, msg := range msgs {
+		records = append(records, &kgo.Record{ //nolint:exhaustruct
+			Key:   []byte(msg.Key),
+			Value: msg.Payload,
+			Headers: []kgo.RecordHeader{
+				{Key: HeaderEventID, Value: []byte(msg.ID.String())},
+				{Key: HeaderEventType, Value: []byte(msg.Type)},
+			},
+			Timestamp: msg.CreatedAt,
+		})
+	}
+
+	if err := p.client.ProduceSync(ctx, records...).FirstErr(); err != nil {
+		return fmt.Errorf("produce to kafka: %w", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	p.client.Close()
+
+	return nil
+}
@@ -0,0 +1,395 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"otusgruz/internal/outbox"
+)
+
+const testTopic = "user-events"
+
+func newCluster(t *testing.T, partitions int32) *kfake.Cluster {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(kfake.SeedTopics(partitions, testTopic))
+	if err != nil {
+		t.Fatalf("start kfake: %v", err)
+	}
+
+	t.Cleanup(cluster.Close)
+
+	return cluster
+}
+
+func newPublisher(t *testing.T, cluster *kfake.Cluster, edit func(*outbox.KafkaOptions)) *outbox.KafkaPublisher {
+	t.Helper()
+
+	opts := outbox.KafkaOptions{
+		Brokers:        cluster.ListenAddrs(),
+		ClientID:       "outbox-test",
+		Topic:          testTopic,
+		Acks:           "all",
+		Linger:         0,
+		BatchMaxBytes:  1 << 20,
+		Retries:        3,
+		ProduceTimeout: 5 * time.Second,
+	}
+
+	if edit != nil {
+		edit(&opts)
+	}
+
+	publisher, err := outbox.NewKafkaPublisher(opts)
+	if err != nil {
+		t.Fatalf("create publisher: %v", err)
+	}
+
+	t.Cleanup(func() { _ = publisher.Close() })
+
+	return publisher
+}
+
+func message(key string, seq int) outbox.Message {
+	payload, _ := json.Marshal(map[string]any{"guid": key, "seq": seq})
+
+	return outbox.Message{
+		ID:        uuid.New(),
+		Key:       key,
+		Type:      outbox.TypeUserUpdated,
+		Payload:   payload,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// consume читает n записей топика с начала.
+func consume(t *testing.T, cluster *kfake.Cluster, n int) []*kgo.Record {
+	t.Helper()
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.ConsumeTopics(testTopic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	if err != nil {
+		t.Fatalf("create consumer: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+
+	for len(records) < n {
+		fetches := client.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("consumed %d of %d records: %v", len(records), n, err)
+		}
+
+		fetches.EachError(func(topic string, partition int32, err error) {
+			t.Fatalf("fetch %s/%d: %v", topic, partition, err)
+		})
+
+		records = append(records, fetches.Records()...)
+	}
+
+	return records
+}
+
+func header(record *kgo.Record, key string) string {
+	for _, h := range record.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
+func TestKafkaPublisherKeepsOrderPerUser(t *testing.T) {
+	cluster := newCluster(t, 4)
+	publisher := newPublisher(t, cluster, nil)
+
+	users := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	want := make(map[string][]string, len(users))
+
+	// события пользователей чередуются и публикуются несколькими пачками, как их отдает relay
+	for batch := range 3 {
+		var msgs []outbox.Message
+
+		for seq := range 4 {
+			for _, user := range users {
+				msg := message(user, batch*4+seq)
+				msgs = append(msgs, msg)
+				want[user] = append(want[user], msg.ID.String())
+			}
+		}
+
+		if err := publisher.Publish(context.Background(), msgs); err != nil {
+			t.Fatalf("publish batch %d: %v", batch, err)
+		}
+	}
+
+	partitions := make(map[string]int32, len(users))
+	got := make(map[string][]string, len(users))
+
+	for _, record := range consume(t, cluster, 3*4*len(users)) {
+		key := string(record.Key)
+
+		if partition, ok := partitions[key]; ok && partition != record.Partition {
+			t.Errorf("user %s: records in partitions %d and %d", key, partition, record.Partition)
+		}
+
+		partitions[key] = record.Partition
+		got[key] = append(got[key], header(record, outbox.HeaderEventID))
+	}
+
+	for _, user := range users {
+		if fmt.Sprint(got[user]) != fmt.Sprint(want[user]) {
+			t.Errorf("user %s: events %v, want %v", user, got[user], want[user])
+		}
+	}
+}
+
+func TestKafkaPublisherRecord(t *testing.T) {
+	cluster := newCluster(t, 1)
+	publisher := newPublisher(t, cluster, nil)
+
+	msg := message(uuid.NewString(), 1)
+	msg.Type = outbox.TypeUserCreated
+
+	if err := publisher.Publish(context.Background(), []outbox.Message{msg}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	record := consume(t, cluster, 1)[0]
+
+	if string(record.Key) != msg.Key {
+		t.Errorf("key %q, want %q", record.Key, msg.Key)
+	}
+
+	if string(record.Value) != string(msg.Payload) {
+		t.Errorf("value %s, want %s", record.Value, msg.Payload)
+	}
+
+	if got := header(record, outbox.HeaderEventID); got != msg.ID.String() {
+		t.Errorf("%s header %q, want %q", outbox.HeaderEventID, got, msg.ID)
+	}
+
+	if got := header(record, outbox.HeaderEventType); got != msg.Type {
+		t.Errorf("%s header %q, want %q", outbox.HeaderEventType, got, msg.Type)
+	}
+
+	if !record.Timestamp.Equal(msg.CreatedAt) {
+		t.Errorf("timestamp %v, want %v", record.Timestamp, msg.CreatedAt)
+	}
+}
+
+func TestKafkaPublisherAcks(t *testing.T) {
+	for acks, want := range map[string]int16{"all": -1, "leader": 1, "none": 0} {
+		t.Run(acks, func(t *testing.T) {
+			cluster := newCluster(t, 1)
+
+			var got atomic.Int32
+
+			got.Store(100)
+			cluster.ControlKey(int16(kmsg.Produce), func(req kmsg.Request) (kmsg.Response, error, bool) {
+				cluster.KeepControl()
+				got.Store(int32(req.(*kmsg.ProduceRequest).Acks))
+
+				return nil, nil, false
+			})
+
+			publisher := newPublisher(t, cluster, func(opts *outbox.KafkaOptions) { opts.Acks = acks })
+
+			if err := publisher.Publish(context.Background(), []outbox.Message{message(uuid.NewString(), 1)}); err != nil {
+				t.Fatalf("publish: %v", err)
+			}
+
+			// без подтверждений Publish не ждет брокера
+			consume(t, cluster, 1)
+
+			if int16(got.Load()) != want {
+				t.Errorf("produce acks %d, want %d", got.Load(), want)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := outbox.NewKafkaPublisher(outbox.KafkaOptions{Acks: "some"}) //nolint:exhaustruct
+		if !errors.Is(err, outbox.ErrUnknownAcks) {
+			t.Errorf("error %v, want %v", err, outbox.ErrUnknownAcks)
+		}
+	})
+}
+
+func TestKafkaPublisherBatching(t *testing.T) {
+	tests := []struct {
+		name          string
+		linger        time.Duration
+		batchMaxBytes int32
+		check         func(t *testing.T, batches []int)
+	}{
+		{
+			name:          "linger collects one batch",
+			linger:        100 * time.Millisecond,
+			batchMaxBytes: 1 << 20,
+			check: func(t *testing.T, batches []int) {
+				t.Helper()
+
+				if len(batches) != 1 {
+					t.Errorf("%d record batches, want 1", len(batches))
+				}
+			},
+		},
+		{
+			name:          "batch max bytes splits batches",
+			linger:        100 * time.Millisecond,
+			batchMaxBytes: 1024,
+			check: func(t *testing.T, batches []int) {
+				t.Helper()
+
+				if len(batches) < 2 {
+					t.Errorf("%d record batches, want several", len(batches))
+				}
+
+				for _, size := range batches {
+					if size > 1024 {
+						t.Errorf("record batch of %d bytes exceeds 1024", size)
+					}
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newCluster(t, 1)
+
+			var (
+				mu      sync.Mutex
+				batches []int
+			)
+
+			cluster.ControlKey(int16(kmsg.Produce), func(req kmsg.Request) (kmsg.Response, error, bool) {
+				cluster.KeepControl()
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, topic := range req.(*kmsg.ProduceRequest).Topics {
+					for _, partition := range topic.Partitions {
+						batches = append(batches, len(partition.Records))
+					}
+				}
+
+				return nil, nil, false
+			})
+
+			publisher := newPublisher(t, cluster, func(opts *outbox.KafkaOptions) {
+				opts.Linger = tt.linger
+				opts.BatchMaxBytes = tt.batchMaxBytes
+			})
+
+			key := uuid.NewString()
+			msgs := make([]outbox.Message, 0, 20)
+
+			for seq := range cap(msgs) {
+				msgs = append(msgs, message(key, seq))
+			}
+
+			if err := publisher.Publish(context.Background(), msgs); err != nil {
+				t.Fatalf("publish: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			tt.check(t, batches)
+		})
+	}
+}
+
+// failProduce отвечает на первые n запросов Produce повторяемой ошибкой NOT_ENOUGH_REPLICAS.
+func failProduce(cluster *kfake.Cluster, n int32) *atomic.Int32 {
+	var attempts atomic.Int32
+
+	cluster.ControlKey(int16(kmsg.Produce), func(req kmsg.Request) (kmsg.Response, error, bool) {
+		cluster.KeepControl()
+
+		if attempts.Add(1) > n {
+			return nil, nil, false
+		}
+
+		produce := req.(*kmsg.ProduceRequest)
+		resp := produce.ResponseKind().(*kmsg.ProduceResponse)
+
+		for _, topic := range produce.Topics {
+			respTopic := kmsg.NewProduceResponseTopic()
+			respTopic.Topic = topic.Topic
+
+			for _, partition := range topic.Partitions {
+				respPartition := kmsg.NewProduceResponseTopicPartition()
+				respPartition.Partition = partition.Partition
+				respPartition.ErrorCode = kerr.NotEnoughReplicas.Code
+				respTopic.Partitions = append(respTopic.Partitions, respPartition)
+			}
+
+			resp.Topics = append(resp.Topics, respTopic)
+		}
+
+		return resp, nil, true
+	})
+
+	return &attempts
+}
+
+// Повторяемая ошибка заставляет клиента обновить метаданные, а это не чаще раза в 5 секунд,
+// поэтому тест ограничивается одним повтором.
+func TestKafkaPublisherRetries(t *testing.T) {
+	t.Run("recovers", func(t *testing.T) {
+		cluster := newCluster(t, 1)
+		attempts := failProduce(cluster, 1)
+		publisher := newPublisher(t, cluster, func(opts *outbox.KafkaOptions) { opts.Retries = 1 })
+
+		msg := message(uuid.NewString(), 1)
+		if err := publisher.Publish(context.Background(), []outbox.Message{msg}); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+
+		if got := attempts.Load(); got != 2 {
+			t.Errorf("%d produce attempts, want 2", got)
+		}
+
+		if got := header(consume(t, cluster, 1)[0], outbox.HeaderEventID); got != msg.ID.String() {
+			t.Errorf("event %s, want %s", got, msg.ID)
+		}
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		cluster := newCluster(t, 1)
+		attempts := failProduce(cluster, 1000)
+		publisher := newPublisher(t, cluster, func(opts *outbox.KafkaOptions) { opts.Retries = 1 })
+
+		err := publisher.Publish(context.Background(), []outbox.Message{message(uuid.NewString(), 1)})
+		if !errors.Is(err, kerr.NotEnoughReplicas) {
+			t.Fatalf("error %v, want %v", err, kerr.NotEnoughReplicas)
+		}
+
+		if got := attempts.Load(); got != 2 {
+			t.Errorf("%d produce attempts, want 2", got)
+		}
+	})
+}
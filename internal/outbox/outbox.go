@@ -0,0 +1,57 @@
+// Package outbox публикует доменные события, записанные в таблицу outbox в транзакции изменения.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+)
+
+// Типы событий жизненного цикла пользователя.
+const (
+	TypeUserCreated  = "user.created"
+	TypeUserUpdated  = "user.updated"
+	TypeUserDeleted  = "user.deleted"
+	TypeUserRestored = "user.restored"
+	TypeUserPurged   = "user.purged"
+)
+
+// UserEventVersion версия схемы UserEvent, увеличивается при несовместимых изменениях.
+const UserEventVersion = 1
+
+// UserEvent тело событий user.*.
+type UserEvent struct {
+	Version    int              `json:"version"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	User       *models.UserData `json:"user"`
+}
+
+func NewUserEvent(user *models.UserData) UserEvent {
+	return UserEvent{
+		Version:    UserEventVersion,
+		OccurredAt: time.Now().UTC(),
+		User:       user,
+	}
+}
+
+// Message событие, передаваемое в Publisher.
+type Message struct {
+	// ID идентификатор события, по нему получатели отбрасывают повторы.
+	ID uuid.UUID
+	// Key ключ упорядочивания, GUID пользователя.
+	Key       string
+	Type      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// Publisher доставляет события получателям. Publish возвращает nil, только если доставлены все сообщения,
+// иначе пачка будет отправлена повторно.
+type Publisher interface {
+	Publish(ctx context.Context, msgs []Message) error
+	Close() error
+}
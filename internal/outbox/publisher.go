@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WriterPublisher пишет события в io.Writer по одному JSON объекту на строку.
+type WriterPublisher struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w} //nolint:exhaustruct
+}
+
+// NewFilePublisher дописывает события в файл path.
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:mnd,gosec
+	if err != nil {
+		return nil, fmt.Errorf("open outbox file: %w", err)
+	}
+
+	return &WriterPublisher{w: f, closer: f}, nil //nolint:exhaustruct
+}
+
+type writtenMessage struct {
+	ID        uuid.UUID       `json:"id"`
+	Key       string          `json:"key"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (p *WriterPublisher) Publish(_ context.Context, msgs []Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	enc := json.NewEncoder(p.w)
+
+	for _, msg := range msgs {
+		err := enc.Encode(writtenMessage{
+			ID:        msg.ID,
+			Key:       msg.Key,
+			Type:      msg.Type,
+			Payload:   msg.Payload,
+			CreatedAt: msg.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("write event %s: %w", msg.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *WriterPublisher) Close() error {
+	if p.closer == nil {
+		return nil
+	}
+
+	return p.closer.Close() //nolint:wrapcheck
+}
+
+// MemoryPublisher хранит опубликованные события в памяти, используется в тестах.
+type MemoryPublisher struct {
+	mu   sync.Mutex
+	msgs []Message
+	err  error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{} //nolint:exhaustruct
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, msgs []Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+
+	p.msgs = append(p.msgs, msgs...)
+
+	return nil
+}
+
+// FailWith заставляет следующие вызовы Publish возвращать err, nil возвращает нормальную работу.
+func (p *MemoryPublisher) FailWith(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+// Messages возвращает копию опубликованных событий.
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.msgs)
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
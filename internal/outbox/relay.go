@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	query "otusgruz/internal/repo"
+)
+
+type repo interface {
+	FetchOutboxBatch(ctx context.Context, batchSize int32) ([]query.Outbox, error)
+	MarkOutboxPublished(ctx context.Context, ids []int64) error
+	InTx(ctx context.Context, fn func(tx repo) error) error
+}
+
+// Relay переносит события из outbox в Publisher. Доставка не реже одного раза: если транзакция
+// не зафиксировалась после публикации, пачка будет отправлена повторно.
+type Relay struct {
+	repo      repo
+	publisher Publisher
+	batchSize int32
+	interval  time.Duration
+}
+
+func NewRelay(repo repo, publisher Publisher, batchSize int32, interval time.Duration) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		batchSize: batchSize,
+		interval:  interval,
+	}
+}
+
+// Run публикует события до отмены ctx. После неполной пачки или ошибки ждет interval.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		published, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			zerolog.Ctx(ctx).Err(err).Msg("relay outbox batch")
+		}
+
+		if err == nil && published == int(r.batchSize) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// RelayOnce публикует одну пачку и возвращает количество опубликованных событий.
+// Строки блокируются через FOR UPDATE SKIP LOCKED, поэтому параллельные relay не берут одни и те же события,
+// но порядок между пачками разных relay не гарантируется.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	var published int
+
+	err := r.repo.InTx(ctx, func(tx repo) error {
+		rows, err := tx.FetchOutboxBatch(ctx, r.batchSize)
+		if err != nil {
+			return fmt.Errorf("fetch outbox batch: %w", err)
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		msgs := make([]Message, 0, len(rows))
+		ids := make([]int64, 0, len(rows))
+
+		for _, row := range rows {
+			msgs = append(msgs, Message{
+				ID:        row.EventID,
+				Key:       row.AggregateID.String(),
+				Type:      row.EventType,
+				Payload:   row.Payload,
+				CreatedAt: row.CreatedAt,
+			})
+			ids = append(ids, row.ID)
+		}
+
+		if err = r.publisher.Publish(ctx, msgs); err != nil {
+			return fmt.Errorf("publish outbox batch: %w", err)
+		}
+
+		if err = tx.MarkOutboxPublished(ctx, ids); err != nil {
+			return fmt.Errorf("mark outbox published: %w", err)
+		}
+
+		published = len(rows)
+
+		return nil
+	})
+
+	return published, err
+}
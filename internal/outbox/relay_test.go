@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// memRepo таблица outbox в памяти. Транзакция откатывает отметки о публикации, если fn вернула ошибку.
+type memRepo struct {
+	rows []query.Outbox
+}
+
+func (m *memRepo) InTx(_ context.Context, fn func(tx repo) error) error {
+	saved := append([]query.Outbox(nil), m.rows...)
+
+	if err := fn(m); err != nil {
+		m.rows = saved
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *memRepo) FetchOutboxBatch(_ context.Context, batchSize int32) ([]query.Outbox, error) {
+	var res []query.Outbox
+
+	for _, row := range m.rows {
+		if !row.PublishedAt.Valid && len(res) < int(batchSize) {
+			res = append(res, row)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memRepo) MarkOutboxPublished(_ context.Context, ids []int64) error {
+	for i := range m.rows {
+		for _, id := range ids {
+			if m.rows[i].ID == id {
+				m.rows[i].PublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *memRepo) add(user uuid.UUID, eventType string) query.Outbox {
+	row := query.Outbox{ //nolint:exhaustruct
+		ID:          int64(len(m.rows) + 1),
+		EventID:     uuid.New(),
+		AggregateID: user,
+		EventType:   eventType,
+		Payload:     json.RawMessage(`{"version":1}`),
+		CreatedAt:   time.Now().UTC(),
+	}
+	m.rows = append(m.rows, row)
+
+	return row
+}
+
+func relayOnce(t *testing.T, r *Relay, want int) {
+	t.Helper()
+
+	published, err := r.RelayOnce(context.Background())
+	if err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+
+	if published != want {
+		t.Fatalf("published %d events, want %d", published, want)
+	}
+}
+
+func TestRelayOnce(t *testing.T) {
+	repo := &memRepo{} //nolint:exhaustruct
+	user := uuid.New()
+	rows := []query.Outbox{
+		repo.add(user, TypeUserCreated),
+		repo.add(user, TypeUserUpdated),
+		repo.add(user, TypeUserDeleted),
+	}
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(repo, publisher, 2, time.Second)
+
+	relayOnce(t, relay, 2)
+	relayOnce(t, relay, 1)
+	relayOnce(t, relay, 0)
+
+	msgs := publisher.Messages()
+	if len(msgs) != len(rows) {
+		t.Fatalf("%d messages, want %d", len(msgs), len(rows))
+	}
+
+	for i, row := range rows {
+		want := Message{
+			ID:        row.EventID,
+			Key:       user.String(),
+			Type:      row.EventType,
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt,
+		}
+
+		if got := msgs[i]; got.ID != want.ID || got.Key != want.Key || got.Type != want.Type ||
+			string(got.Payload) != string(want.Payload) || !got.CreatedAt.Equal(want.CreatedAt) {
+			t.Errorf("message %d: %+v, want %+v", i, got, want)
+		}
+	}
+
+	for _, row := range repo.rows {
+		if !row.PublishedAt.Valid {
+			t.Errorf("event %d is not marked as published", row.ID)
+		}
+	}
+}
+
+func TestRelayOnceRetriesFailedBatch(t *testing.T) {
+	repo := &memRepo{} //nolint:exhaustruct
+	row := repo.add(uuid.New(), TypeUserCreated)
+
+	publisher := NewMemoryPublisher()
+	relay := NewRelay(repo, publisher, 10, time.Second)
+
+	errBroker := errors.New("broker unavailable")
+	publisher.FailWith(errBroker)
+
+	if _, err := relay.RelayOnce(context.Background()); !errors.Is(err, errBroker) {
+		t.Fatalf("error %v, want %v", err, errBroker)
+	}
+
+	if repo.rows[0].PublishedAt.Valid {
+		t.Fatal("event is marked as published after a failed publish")
+	}
+
+	publisher.FailWith(nil)
+	relayOnce(t, relay, 1)
+
+	if msgs := publisher.Messages(); len(msgs) != 1 || msgs[0].ID != row.EventID {
+		t.Errorf("messages %+v, want event %s once", msgs, row.EventID)
+	}
+}
@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	query "otusgruz/internal/repo"
+)
+
+type Repo struct {
+	db *sql.DB
+	tx *sql.Tx
+	q  *query.Queries
+}
+
+func NewRepo(db *sql.DB, q *query.Queries) *Repo {
+	return &Repo{db: db, q: q} //nolint:exhaustruct
+}
+
+// InTx выполняет fn в транзакции. Вложенный вызов переиспользует уже открытую транзакцию.
+func (r *Repo) InTx(ctx context.Context, fn func(tx repo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	if err = fn(&Repo{db: r.db, tx: tx, q: r.q.WithTx(tx)}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) FetchOutboxBatch(ctx context.Context, batchSize int32) ([]query.Outbox, error) {
+	return r.q.FetchOutboxBatch(ctx, batchSize) //nolint:wrapcheck
+}
+
+func (r *Repo) MarkOutboxPublished(ctx context.Context, ids []int64) error {
+	return r.q.MarkOutboxPublished(ctx, ids) //nolint:wrapcheck
+}
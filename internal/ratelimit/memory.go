@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval как часто Memory удаляет полностью наполненные бакеты.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt после этого времени бакет полон и его можно удалить
+	fullAt time.Time
+}
+
+// Memory хранит бакеты в памяти процесса: каждый экземпляр сервиса считает запросы отдельно.
+type Memory struct {
+	// now часы бакетов, в тестах подменяются
+	now func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]bucket
+	sweptAt time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{now: time.Now, buckets: make(map[string]bucket), sweptAt: time.Now()} //nolint:exhaustruct
+}
+
+func (m *Memory) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	now := m.now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(now)
+
+	tokens := float64(limit.Requests)
+	if b, ok := m.buckets[key]; ok {
+		tokens = min(tokens, b.tokens+now.Sub(b.updatedAt).Seconds()*limit.rate())
+	}
+
+	if tokens < 1 {
+		return newResult(limit, tokens, false), nil
+	}
+
+	tokens--
+	m.buckets[key] = bucket{tokens: tokens, updatedAt: now, fullAt: now.Add(limit.Period)}
+
+	return newResult(limit, tokens, true), nil
+}
+
+// sweep удаляет полные бакеты, иначе карта растет с каждым новым клиентом.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.sweptAt) < sweepInterval {
+		return
+	}
+
+	for key, b := range m.buckets {
+		if now.After(b.fullAt) {
+			delete(m.buckets, key)
+		}
+	}
+
+	m.sweptAt = now
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	query "otusgruz/internal/repo"
+)
+
+// cleanupInterval как часто экземпляр удаляет из rate_limit_buckets полностью наполненные бакеты.
+const cleanupInterval = time.Minute
+
+type repo interface {
+	TakeRateLimitToken(ctx context.Context, arg query.TakeRateLimitTokenParams) (query.TakeRateLimitTokenRow, error)
+	DeleteExpiredRateLimitBuckets(ctx context.Context) (int64, error)
+}
+
+// Postgres хранит бакеты в таблице rate_limit_buckets, лимит общий для всех экземпляров сервиса.
+// Время считается по часам базы, поэтому расхождение часов экземпляров на счет не влияет.
+type Postgres struct {
+	repo repo
+	// cleanedAt время последней очистки в наносекундах unix
+	cleanedAt atomic.Int64
+}
+
+func NewPostgres(q *query.Queries) *Postgres {
+	p := &Postgres{repo: q} //nolint:exhaustruct
+	p.cleanedAt.Store(time.Now().UnixNano())
+
+	return p
+}
+
+func (p *Postgres) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	row, err := p.repo.TakeRateLimitToken(ctx, query.TakeRateLimitTokenParams{
+		Key:   key,
+		Burst: float64(limit.Requests),
+		Rate:  limit.rate(),
+	})
+
+	// строку вставил параллельный запрос, не видимый в снимке запроса, и токенов в ней не осталось
+	if errors.Is(err, sql.ErrNoRows) {
+		return newResult(limit, 0, false), nil
+	}
+
+	if err != nil {
+		return Result{}, fmt.Errorf("take rate limit token: %w", err)
+	}
+
+	p.cleanup(ctx)
+
+	return newResult(limit, row.Tokens, row.Allowed), nil
+}
+
+// cleanup выполняется одним из запросов не чаще раза в cleanupInterval. Ошибка очистки не мешает запросу.
+func (p *Postgres) cleanup(ctx context.Context) {
+	last := p.cleanedAt.Load()
+	now := time.Now().UnixNano()
+
+	if time.Duration(now-last) < cleanupInterval || !p.cleanedAt.CompareAndSwap(last, now) {
+		return
+	}
+
+	if _, err := p.repo.DeleteExpiredRateLimitBuckets(ctx); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("delete expired rate limit buckets")
+	}
+}
@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	query "otusgruz/internal/repo"
+)
+
+// memBucket строка rate_limit_buckets в memRepo.
+type memBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	expiresAt time.Time
+}
+
+// memRepo таблица rate_limit_buckets в памяти с той же логикой, что у TakeRateLimitToken.
+// Время берется из now, как в запросе - из часов базы.
+type memRepo struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]memBucket
+	// err возвращает TakeRateLimitToken вместо списания
+	err      error
+	cleanups int
+}
+
+func newMemRepo(now func() time.Time) *memRepo {
+	return &memRepo{now: now, buckets: make(map[string]memBucket)} //nolint:exhaustruct
+}
+
+func newTestPostgres(r *memRepo) *Postgres {
+	p := &Postgres{repo: r} //nolint:exhaustruct
+	p.cleanedAt.Store(time.Now().UnixNano())
+
+	return p
+}
+
+func (m *memRepo) TakeRateLimitToken(
+	_ context.Context, arg query.TakeRateLimitTokenParams,
+) (query.TakeRateLimitTokenRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return query.TakeRateLimitTokenRow{}, m.err //nolint:exhaustruct
+	}
+
+	now := m.now()
+	expiresAt := now.Add(time.Duration(arg.Burst / arg.Rate * float64(time.Second)))
+
+	b, ok := m.buckets[arg.Key]
+	if !ok {
+		m.buckets[arg.Key] = memBucket{tokens: arg.Burst - 1, updatedAt: now, expiresAt: expiresAt}
+
+		return query.TakeRateLimitTokenRow{Tokens: arg.Burst - 1, Allowed: true}, nil
+	}
+
+	tokens := min(arg.Burst, b.tokens+now.Sub(b.updatedAt).Seconds()*arg.Rate)
+	if tokens < 1 {
+		return query.TakeRateLimitTokenRow{Tokens: tokens, Allowed: false}, nil
+	}
+
+	m.buckets[arg.Key] = memBucket{tokens: tokens - 1, updatedAt: now, expiresAt: expiresAt}
+
+	return query.TakeRateLimitTokenRow{Tokens: tokens - 1, Allowed: true}, nil
+}
+
+func (m *memRepo) DeleteExpiredRateLimitBuckets(context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.cleanups++
+	deleted := int64(0)
+
+	for key, b := range m.buckets {
+		if b.expiresAt.Before(m.now()) {
+			delete(m.buckets, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func TestPostgresTakeErrors(t *testing.T) {
+	errStorage := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantErr     error
+		wantAllowed bool
+	}{
+		{
+			// параллельная вставка бакета не видна в снимке запроса, и ни одна строка не вернулась
+			name:        "concurrent insert",
+			err:         sql.ErrNoRows,
+			wantAllowed: false,
+		},
+		{
+			name:    "storage error",
+			err:     errStorage,
+			wantErr: errStorage,
+		},
+	}
+
+	limit := Limit{Requests: 2, Period: time.Second}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newMemRepo(time.Now)
+			r.err = tt.err
+
+			res, err := newTestPostgres(r).Take(context.Background(), "a", limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if res.Allowed != tt.wantAllowed || res.Remaining != 0 || res.RetryAfter != limit.Period/2 {
+				t.Errorf("result %+v, want denied with retry after %s", res, limit.Period/2)
+			}
+		})
+	}
+}
+
+func TestPostgresCleanupInterval(t *testing.T) {
+	r := newMemRepo(time.Now)
+	p := newTestPostgres(r)
+	limit := Limit{Requests: 10, Period: time.Second}
+
+	take := func() {
+		t.Helper()
+
+		if _, err := p.Take(context.Background(), "a", limit); err != nil {
+			t.Fatalf("take: %v", err)
+		}
+	}
+
+	take()
+
+	if r.cleanups != 0 {
+		t.Fatalf("%d cleanups right after start, want 0", r.cleanups)
+	}
+
+	// очистка выполнялась давно: следующий запрос ее запускает, а последующие - нет
+	p.cleanedAt.Store(time.Now().Add(-cleanupInterval).UnixNano())
+
+	take()
+	take()
+
+	if r.cleanups != 1 {
+		t.Errorf("%d cleanups after the interval passed, want 1", r.cleanups)
+	}
+}
@@ -0,0 +1,90 @@
+// Package ratelimit ограничение частоты запросов алгоритмом token bucket.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidLimit = errors.New("invalid rate limit")
+
+// Limit бакет емкостью Requests токенов, который пополняется равномерно и наполняется полностью за Period.
+// Каждый запрос списывает токен, поэтому клиент может сделать Requests запросов подряд, а дальше - по одному
+// каждые Period / Requests.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit разбирает лимит в формате <запросов>/<период>, например 10/1m.
+func ParseLimit(s string) (Limit, error) {
+	rawRequests, rawPeriod, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("%w %q: expected <requests>/<period>", ErrInvalidLimit, s)
+	}
+
+	requests, err := strconv.Atoi(rawRequests)
+	if err != nil || requests <= 0 {
+		return Limit{}, fmt.Errorf("%w %q: requests must be a positive integer", ErrInvalidLimit, s)
+	}
+
+	period, err := time.ParseDuration(rawPeriod)
+	if err != nil || period <= 0 {
+		return Limit{}, fmt.Errorf("%w %q: period must be a positive duration", ErrInvalidLimit, s)
+	}
+
+	return Limit{Requests: requests, Period: period}, nil
+}
+
+func (l Limit) String() string {
+	return strconv.Itoa(l.Requests) + "/" + l.Period.String()
+}
+
+// rate токенов в секунду.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result итог списания токена.
+type Result struct {
+	Allowed bool
+	Limit   int
+	// Remaining сколько запросов еще можно сделать без ожидания.
+	Remaining int
+	// Reset через сколько бакет наполнится полностью.
+	Reset time.Duration
+	// RetryAfter через сколько появится токен для отклоненного запроса.
+	RetryAfter time.Duration
+}
+
+// Store хранилище бакетов. Take пополняет бакет key за прошедшее время и списывает токен, если он есть.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// newResult tokens - остаток после списания для разрешенного запроса или текущий остаток для отклоненного.
+func newResult(limit Limit, tokens float64, allowed bool) Result {
+	tokens = max(0, tokens)
+
+	res := Result{ //nolint:exhaustruct
+		Allowed:   allowed,
+		Limit:     limit.Requests,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((float64(limit.Requests) - tokens) / limit.rate()),
+	}
+
+	if !allowed {
+		res.RetryAfter = seconds((1 - tokens) / limit.rate())
+	}
+
+	return res
+}
+
+func seconds(v float64) time.Duration {
+	return time.Duration(max(0, v) * float64(time.Second))
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// clock часы, которые двигает тест.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+// take списание токена в шаге сценария после ожидания wait.
+type take struct {
+	wait          time.Duration
+	key           string
+	wantAllowed   bool
+	wantRemaining int
+	wantReset     time.Duration
+	wantRetry     time.Duration
+}
+
+func TestStoreTake(t *testing.T) {
+	stores := []struct {
+		name string
+		new  func(c *clock) Store
+	}{
+		{
+			name: "memory",
+			new: func(c *clock) Store {
+				m := NewMemory()
+				m.now = c.Now
+
+				return m
+			},
+		},
+		{
+			name: "postgres",
+			new: func(c *clock) Store {
+				return newTestPostgres(newMemRepo(c.Now))
+			},
+		},
+	}
+
+	// 2 запроса за 2 секунды: токен каждую секунду
+	limit := Limit{Requests: 2, Period: 2 * time.Second}
+
+	tests := []struct {
+		name  string
+		takes []take
+	}{
+		{
+			name: "burst then limited",
+			takes: []take{
+				{key: "a", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+				{key: "a", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+				{key: "a", wantAllowed: false, wantRemaining: 0, wantReset: 2 * time.Second, wantRetry: time.Second},
+			},
+		},
+		{
+			name: "refill",
+			takes: []take{
+				{key: "a", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+				{key: "a", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+				{
+					wait: 500 * time.Millisecond, key: "a",
+					wantAllowed: false, wantRemaining: 0, wantReset: 1500 * time.Millisecond, wantRetry: 500 * time.Millisecond,
+				},
+				{wait: 500 * time.Millisecond, key: "a", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+			},
+		},
+		{
+			name: "refill up to burst",
+			takes: []take{
+				{key: "a", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+				{wait: time.Hour, key: "a", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+			},
+		},
+		{
+			name: "separate buckets",
+			takes: []take{
+				{key: "a", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+				{key: "a", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+				{key: "b", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+			},
+		},
+	}
+
+	for _, store := range stores {
+		for _, tt := range tests {
+			t.Run(store.name+"/"+tt.name, func(t *testing.T) {
+				c := &clock{now: time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)}
+				s := store.new(c)
+
+				for i, step := range tt.takes {
+					c.now = c.now.Add(step.wait)
+
+					res, err := s.Take(context.Background(), step.key, limit)
+					if err != nil {
+						t.Fatalf("take %d: %v", i, err)
+					}
+
+					want := Result{
+						Allowed:    step.wantAllowed,
+						Limit:      limit.Requests,
+						Remaining:  step.wantRemaining,
+						Reset:      step.wantReset,
+						RetryAfter: step.wantRetry,
+					}
+					if res != want {
+						t.Errorf("take %d: %+v, want %+v", i, res, want)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Limit
+		wantErr bool
+	}{
+		{in: "10/1m", want: Limit{Requests: 10, Period: time.Minute}},
+		{in: " 5/30s ", want: Limit{Requests: 5, Period: 30 * time.Second}},
+		{in: "10", wantErr: true},
+		{in: "0/1m", wantErr: true},
+		{in: "10/0s", wantErr: true},
+		{in: "ten/1m", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLimit(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("limit %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+-- name: InsertAPIKey :one
+INSERT INTO api_keys (id, name, prefix, key_hash, scopes, created_by, expires_at)
+VALUES (@id, @name, @prefix, @key_hash, @scopes, sqlc.narg('created_by'), sqlc.narg('expires_at'))
+RETURNING *;
+
+-- name: GetAPIKey :one
+SELECT * FROM api_keys WHERE id = @id;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT * FROM api_keys WHERE prefix = @prefix;
+
+-- name: ListAPIKeys :many
+SELECT * FROM api_keys ORDER BY created_at, id;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys SET revoked_at = now() WHERE id = @id AND revoked_at IS NULL;
+
+-- name: TouchAPIKey :exec
+UPDATE api_keys SET last_used_at = now()
+WHERE id = @id AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute');
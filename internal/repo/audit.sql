@@ -0,0 +1,10 @@
+-- name: InsertUserAudit :exec
+INSERT INTO user_audit (user_guid, actor, actor_type, action, before, after, details, request_id)
+VALUES (@user_guid, sqlc.narg('actor'), sqlc.narg('actor_type'), @action, sqlc.narg('before'), sqlc.narg('after'), sqlc.narg('details'), sqlc.narg('request_id'));
+
+-- name: ListUserAudit :many
+SELECT * FROM user_audit
+WHERE user_guid = @user_guid
+  AND (sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id'))
+ORDER BY id DESC
+LIMIT @row_limit;
@@ -0,0 +1,56 @@
+-- name: InsertCredentials :exec
+INSERT INTO credentials (user_guid, login, password_hash) VALUES ($1, $2, $3);
+
+-- name: GetCredentialsByLogin :one
+SELECT c.user_guid, c.password_hash, u.is_deleted
+FROM credentials c
+JOIN users u ON u.guid = c.user_guid
+WHERE c.login = @login;
+
+-- name: InsertRefreshToken :exec
+INSERT INTO refresh_tokens (token_hash, family_id, user_guid, expires_at) VALUES ($1, $2, $3, $4);
+
+-- name: GetRefreshTokenForUpdate :one
+SELECT * FROM refresh_tokens WHERE token_hash = @token_hash FOR UPDATE;
+
+-- name: RevokeRefreshToken :execrows
+UPDATE refresh_tokens SET revoked_at = now() WHERE token_hash = @token_hash AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokenFamily :execrows
+UPDATE refresh_tokens SET revoked_at = now() WHERE family_id = @family_id AND revoked_at IS NULL;
+
+-- name: GetUserRoles :many
+SELECT role FROM user_roles WHERE user_guid = @user_guid ORDER BY role;
+
+-- name: InsertUserRole :exec
+INSERT INTO user_roles (user_guid, role) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+
+-- name: DeleteUserRole :execrows
+DELETE FROM user_roles WHERE user_guid = $1 AND role = $2;
+
+-- name: GetUserByEmail :one
+SELECT u.guid, u.email, u.email_verified_at, u.is_deleted, c.user_guid IS NOT NULL AS has_credentials
+FROM users u
+LEFT JOIN credentials c ON c.user_guid = u.guid
+WHERE lower(u.email) = lower(@email::text);
+
+-- name: UpdatePasswordHash :execrows
+UPDATE credentials SET password_hash = @password_hash, updated_at = now() WHERE user_guid = @user_guid;
+
+-- name: RevokeUserRefreshTokens :execrows
+UPDATE refresh_tokens SET revoked_at = now() WHERE user_guid = @user_guid AND revoked_at IS NULL;
+
+-- name: InsertEmailToken :exec
+INSERT INTO email_tokens (token_hash, user_guid, purpose, email, expires_at) VALUES ($1, $2, $3, $4, $5);
+
+-- name: InvalidateEmailTokens :exec
+UPDATE email_tokens SET used_at = now() WHERE user_guid = $1 AND purpose = $2 AND used_at IS NULL;
+
+-- name: UseEmailToken :one
+UPDATE email_tokens SET used_at = now()
+WHERE token_hash = @token_hash AND purpose = @purpose AND used_at IS NULL AND expires_at > now()
+RETURNING *;
+
+-- name: MarkEmailVerified :execrows
+UPDATE users SET email_verified_at = now(), updated_at = now(), version = version + 1
+WHERE guid = @guid AND NOT is_deleted AND lower(email) = lower(@email::text);
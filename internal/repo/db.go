@@ -24,43 +24,619 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.claimWebhookDeliveriesStmt, err = db.PrepareContext(ctx, claimWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimWebhookDeliveries: %w", err)
+	}
+	if q.confirmUserTOTPStmt, err = db.PrepareContext(ctx, confirmUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query ConfirmUserTOTP: %w", err)
+	}
+	if q.countRecoveryCodesStmt, err = db.PrepareContext(ctx, countRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query CountRecoveryCodes: %w", err)
+	}
+	if q.countUsersStmt, err = db.PrepareContext(ctx, countUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUsers: %w", err)
+	}
+	if q.deleteExpiredIdempotencyKeysStmt, err = db.PrepareContext(ctx, deleteExpiredIdempotencyKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredIdempotencyKeys: %w", err)
+	}
+	if q.deleteExpiredRateLimitBucketsStmt, err = db.PrepareContext(ctx, deleteExpiredRateLimitBuckets); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredRateLimitBuckets: %w", err)
+	}
+	if q.deleteRecoveryCodesStmt, err = db.PrepareContext(ctx, deleteRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRecoveryCodes: %w", err)
+	}
 	if q.deleteUserStmt, err = db.PrepareContext(ctx, deleteUser); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUser: %w", err)
 	}
+	if q.deleteUserRoleStmt, err = db.PrepareContext(ctx, deleteUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserRole: %w", err)
+	}
+	if q.deleteUserTOTPStmt, err = db.PrepareContext(ctx, deleteUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserTOTP: %w", err)
+	}
+	if q.deleteWebhookStmt, err = db.PrepareContext(ctx, deleteWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWebhook: %w", err)
+	}
+	if q.enqueueWebhookDeliveriesStmt, err = db.PrepareContext(ctx, enqueueWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query EnqueueWebhookDeliveries: %w", err)
+	}
+	if q.fetchOutboxBatchStmt, err = db.PrepareContext(ctx, fetchOutboxBatch); err != nil {
+		return nil, fmt.Errorf("error preparing query FetchOutboxBatch: %w", err)
+	}
+	if q.getAPIKeyStmt, err = db.PrepareContext(ctx, getAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIKey: %w", err)
+	}
+	if q.getAPIKeyByPrefixStmt, err = db.PrepareContext(ctx, getAPIKeyByPrefix); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIKeyByPrefix: %w", err)
+	}
+	if q.getCredentialsByLoginStmt, err = db.PrepareContext(ctx, getCredentialsByLogin); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCredentialsByLogin: %w", err)
+	}
+	if q.getIdempotencyKeyStmt, err = db.PrepareContext(ctx, getIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIdempotencyKey: %w", err)
+	}
+	if q.getRefreshTokenForUpdateStmt, err = db.PrepareContext(ctx, getRefreshTokenForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRefreshTokenForUpdate: %w", err)
+	}
 	if q.getUserStmt, err = db.PrepareContext(ctx, getUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUser: %w", err)
 	}
+	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
+	}
+	if q.getUserForUpdateStmt, err = db.PrepareContext(ctx, getUserForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserForUpdate: %w", err)
+	}
+	if q.getUserIdentityStmt, err = db.PrepareContext(ctx, getUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserIdentity: %w", err)
+	}
+	if q.getUserRolesStmt, err = db.PrepareContext(ctx, getUserRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserRoles: %w", err)
+	}
+	if q.getUserTOTPStmt, err = db.PrepareContext(ctx, getUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserTOTP: %w", err)
+	}
+	if q.getUserTOTPForUpdateStmt, err = db.PrepareContext(ctx, getUserTOTPForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserTOTPForUpdate: %w", err)
+	}
+	if q.getWebhookStmt, err = db.PrepareContext(ctx, getWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhook: %w", err)
+	}
+	if q.getWebhookDeliveryStmt, err = db.PrepareContext(ctx, getWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWebhookDelivery: %w", err)
+	}
+	if q.insertAPIKeyStmt, err = db.PrepareContext(ctx, insertAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertAPIKey: %w", err)
+	}
+	if q.insertCredentialsStmt, err = db.PrepareContext(ctx, insertCredentials); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertCredentials: %w", err)
+	}
+	if q.insertEmailTokenStmt, err = db.PrepareContext(ctx, insertEmailToken); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertEmailToken: %w", err)
+	}
+	if q.insertIdempotencyKeyStmt, err = db.PrepareContext(ctx, insertIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertIdempotencyKey: %w", err)
+	}
+	if q.insertOutboxEventStmt, err = db.PrepareContext(ctx, insertOutboxEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertOutboxEvent: %w", err)
+	}
+	if q.insertRecoveryCodeStmt, err = db.PrepareContext(ctx, insertRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertRecoveryCode: %w", err)
+	}
+	if q.insertRefreshTokenStmt, err = db.PrepareContext(ctx, insertRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertRefreshToken: %w", err)
+	}
+	if q.insertSessionStmt, err = db.PrepareContext(ctx, insertSession); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertSession: %w", err)
+	}
 	if q.insertUserStmt, err = db.PrepareContext(ctx, insertUser); err != nil {
 		return nil, fmt.Errorf("error preparing query InsertUser: %w", err)
 	}
+	if q.insertUserAuditStmt, err = db.PrepareContext(ctx, insertUserAudit); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertUserAudit: %w", err)
+	}
+	if q.insertUserIdentityStmt, err = db.PrepareContext(ctx, insertUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertUserIdentity: %w", err)
+	}
+	if q.insertUserRoleStmt, err = db.PrepareContext(ctx, insertUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertUserRole: %w", err)
+	}
+	if q.insertWebhookStmt, err = db.PrepareContext(ctx, insertWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertWebhook: %w", err)
+	}
+	if q.insertWebhookAttemptStmt, err = db.PrepareContext(ctx, insertWebhookAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertWebhookAttempt: %w", err)
+	}
+	if q.invalidateEmailTokensStmt, err = db.PrepareContext(ctx, invalidateEmailTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query InvalidateEmailTokens: %w", err)
+	}
+	if q.listAPIKeysStmt, err = db.PrepareContext(ctx, listAPIKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAPIKeys: %w", err)
+	}
+	if q.listUserAuditStmt, err = db.PrepareContext(ctx, listUserAudit); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUserAudit: %w", err)
+	}
+	if q.listUserSessionsStmt, err = db.PrepareContext(ctx, listUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUserSessions: %w", err)
+	}
+	if q.listUsersAscStmt, err = db.PrepareContext(ctx, listUsersAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUsersAsc: %w", err)
+	}
+	if q.listUsersDescStmt, err = db.PrepareContext(ctx, listUsersDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUsersDesc: %w", err)
+	}
+	if q.listWebhookAttemptsStmt, err = db.PrepareContext(ctx, listWebhookAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookAttempts: %w", err)
+	}
+	if q.listWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveries: %w", err)
+	}
+	if q.listWebhooksStmt, err = db.PrepareContext(ctx, listWebhooks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhooks: %w", err)
+	}
+	if q.markEmailVerifiedStmt, err = db.PrepareContext(ctx, markEmailVerified); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkEmailVerified: %w", err)
+	}
+	if q.markOutboxPublishedStmt, err = db.PrepareContext(ctx, markOutboxPublished); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkOutboxPublished: %w", err)
+	}
+	if q.patchUserStmt, err = db.PrepareContext(ctx, patchUser); err != nil {
+		return nil, fmt.Errorf("error preparing query PatchUser: %w", err)
+	}
+	if q.purgeDeletedUsersStmt, err = db.PrepareContext(ctx, purgeDeletedUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query PurgeDeletedUsers: %w", err)
+	}
+	if q.purgeUserStmt, err = db.PrepareContext(ctx, purgeUser); err != nil {
+		return nil, fmt.Errorf("error preparing query PurgeUser: %w", err)
+	}
+	if q.redeliverWebhookDeliveryStmt, err = db.PrepareContext(ctx, redeliverWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query RedeliverWebhookDelivery: %w", err)
+	}
+	if q.restoreUserStmt, err = db.PrepareContext(ctx, restoreUser); err != nil {
+		return nil, fmt.Errorf("error preparing query RestoreUser: %w", err)
+	}
+	if q.revokeAPIKeyStmt, err = db.PrepareContext(ctx, revokeAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeAPIKey: %w", err)
+	}
+	if q.revokeRefreshTokenStmt, err = db.PrepareContext(ctx, revokeRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshToken: %w", err)
+	}
+	if q.revokeRefreshTokenFamilyStmt, err = db.PrepareContext(ctx, revokeRefreshTokenFamily); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshTokenFamily: %w", err)
+	}
+	if q.revokeSessionStmt, err = db.PrepareContext(ctx, revokeSession); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeSession: %w", err)
+	}
+	if q.revokeUserRefreshTokensStmt, err = db.PrepareContext(ctx, revokeUserRefreshTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserRefreshTokens: %w", err)
+	}
+	if q.revokeUserSessionsStmt, err = db.PrepareContext(ctx, revokeUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserSessions: %w", err)
+	}
+	if q.sessionActiveStmt, err = db.PrepareContext(ctx, sessionActive); err != nil {
+		return nil, fmt.Errorf("error preparing query SessionActive: %w", err)
+	}
+	if q.takeRateLimitTokenStmt, err = db.PrepareContext(ctx, takeRateLimitToken); err != nil {
+		return nil, fmt.Errorf("error preparing query TakeRateLimitToken: %w", err)
+	}
+	if q.touchAPIKeyStmt, err = db.PrepareContext(ctx, touchAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchAPIKey: %w", err)
+	}
+	if q.touchSessionStmt, err = db.PrepareContext(ctx, touchSession); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchSession: %w", err)
+	}
+	if q.touchUserIdentityStmt, err = db.PrepareContext(ctx, touchUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchUserIdentity: %w", err)
+	}
+	if q.updatePasswordHashStmt, err = db.PrepareContext(ctx, updatePasswordHash); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePasswordHash: %w", err)
+	}
+	if q.updateTOTPAttemptsStmt, err = db.PrepareContext(ctx, updateTOTPAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTOTPAttempts: %w", err)
+	}
 	if q.updateUserStmt, err = db.PrepareContext(ctx, updateUser); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUser: %w", err)
 	}
+	if q.updateWebhookStmt, err = db.PrepareContext(ctx, updateWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhook: %w", err)
+	}
+	if q.updateWebhookDeliveryStmt, err = db.PrepareContext(ctx, updateWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWebhookDelivery: %w", err)
+	}
+	if q.upsertUserTOTPStmt, err = db.PrepareContext(ctx, upsertUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertUserTOTP: %w", err)
+	}
+	if q.useEmailTokenStmt, err = db.PrepareContext(ctx, useEmailToken); err != nil {
+		return nil, fmt.Errorf("error preparing query UseEmailToken: %w", err)
+	}
+	if q.useRecoveryCodeStmt, err = db.PrepareContext(ctx, useRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UseRecoveryCode: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.claimWebhookDeliveriesStmt != nil {
+		if cerr := q.claimWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.confirmUserTOTPStmt != nil {
+		if cerr := q.confirmUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing confirmUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.countRecoveryCodesStmt != nil {
+		if cerr := q.countRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countRecoveryCodesStmt: %w", cerr)
+		}
+	}
+	if q.countUsersStmt != nil {
+		if cerr := q.countUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUsersStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredIdempotencyKeysStmt != nil {
+		if cerr := q.deleteExpiredIdempotencyKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredIdempotencyKeysStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredRateLimitBucketsStmt != nil {
+		if cerr := q.deleteExpiredRateLimitBucketsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredRateLimitBucketsStmt: %w", cerr)
+		}
+	}
+	if q.deleteRecoveryCodesStmt != nil {
+		if cerr := q.deleteRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRecoveryCodesStmt: %w", cerr)
+		}
+	}
 	if q.deleteUserStmt != nil {
 		if cerr := q.deleteUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteUserStmt: %w", cerr)
 		}
 	}
+	if q.deleteUserRoleStmt != nil {
+		if cerr := q.deleteUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserRoleStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserTOTPStmt != nil {
+		if cerr := q.deleteUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.deleteWebhookStmt != nil {
+		if cerr := q.deleteWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWebhookStmt: %w", cerr)
+		}
+	}
+	if q.enqueueWebhookDeliveriesStmt != nil {
+		if cerr := q.enqueueWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing enqueueWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.fetchOutboxBatchStmt != nil {
+		if cerr := q.fetchOutboxBatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing fetchOutboxBatchStmt: %w", cerr)
+		}
+	}
+	if q.getAPIKeyStmt != nil {
+		if cerr := q.getAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.getAPIKeyByPrefixStmt != nil {
+		if cerr := q.getAPIKeyByPrefixStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIKeyByPrefixStmt: %w", cerr)
+		}
+	}
+	if q.getCredentialsByLoginStmt != nil {
+		if cerr := q.getCredentialsByLoginStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCredentialsByLoginStmt: %w", cerr)
+		}
+	}
+	if q.getIdempotencyKeyStmt != nil {
+		if cerr := q.getIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.getRefreshTokenForUpdateStmt != nil {
+		if cerr := q.getRefreshTokenForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRefreshTokenForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getUserStmt != nil {
 		if cerr := q.getUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserStmt: %w", cerr)
 		}
 	}
+	if q.getUserByEmailStmt != nil {
+		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
+		}
+	}
+	if q.getUserForUpdateStmt != nil {
+		if cerr := q.getUserForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getUserIdentityStmt != nil {
+		if cerr := q.getUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.getUserRolesStmt != nil {
+		if cerr := q.getUserRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserRolesStmt: %w", cerr)
+		}
+	}
+	if q.getUserTOTPStmt != nil {
+		if cerr := q.getUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.getUserTOTPForUpdateStmt != nil {
+		if cerr := q.getUserTOTPForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserTOTPForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookStmt != nil {
+		if cerr := q.getWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookStmt: %w", cerr)
+		}
+	}
+	if q.getWebhookDeliveryStmt != nil {
+		if cerr := q.getWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.insertAPIKeyStmt != nil {
+		if cerr := q.insertAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.insertCredentialsStmt != nil {
+		if cerr := q.insertCredentialsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertCredentialsStmt: %w", cerr)
+		}
+	}
+	if q.insertEmailTokenStmt != nil {
+		if cerr := q.insertEmailTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertEmailTokenStmt: %w", cerr)
+		}
+	}
+	if q.insertIdempotencyKeyStmt != nil {
+		if cerr := q.insertIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.insertOutboxEventStmt != nil {
+		if cerr := q.insertOutboxEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertOutboxEventStmt: %w", cerr)
+		}
+	}
+	if q.insertRecoveryCodeStmt != nil {
+		if cerr := q.insertRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.insertRefreshTokenStmt != nil {
+		if cerr := q.insertRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.insertSessionStmt != nil {
+		if cerr := q.insertSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertSessionStmt: %w", cerr)
+		}
+	}
 	if q.insertUserStmt != nil {
 		if cerr := q.insertUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing insertUserStmt: %w", cerr)
 		}
 	}
+	if q.insertUserAuditStmt != nil {
+		if cerr := q.insertUserAuditStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertUserAuditStmt: %w", cerr)
+		}
+	}
+	if q.insertUserIdentityStmt != nil {
+		if cerr := q.insertUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.insertUserRoleStmt != nil {
+		if cerr := q.insertUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertUserRoleStmt: %w", cerr)
+		}
+	}
+	if q.insertWebhookStmt != nil {
+		if cerr := q.insertWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertWebhookStmt: %w", cerr)
+		}
+	}
+	if q.insertWebhookAttemptStmt != nil {
+		if cerr := q.insertWebhookAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertWebhookAttemptStmt: %w", cerr)
+		}
+	}
+	if q.invalidateEmailTokensStmt != nil {
+		if cerr := q.invalidateEmailTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing invalidateEmailTokensStmt: %w", cerr)
+		}
+	}
+	if q.listAPIKeysStmt != nil {
+		if cerr := q.listAPIKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAPIKeysStmt: %w", cerr)
+		}
+	}
+	if q.listUserAuditStmt != nil {
+		if cerr := q.listUserAuditStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUserAuditStmt: %w", cerr)
+		}
+	}
+	if q.listUserSessionsStmt != nil {
+		if cerr := q.listUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.listUsersAscStmt != nil {
+		if cerr := q.listUsersAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUsersAscStmt: %w", cerr)
+		}
+	}
+	if q.listUsersDescStmt != nil {
+		if cerr := q.listUsersDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUsersDescStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookAttemptsStmt != nil {
+		if cerr := q.listWebhookAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveriesStmt != nil {
+		if cerr := q.listWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhooksStmt != nil {
+		if cerr := q.listWebhooksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhooksStmt: %w", cerr)
+		}
+	}
+	if q.markEmailVerifiedStmt != nil {
+		if cerr := q.markEmailVerifiedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markEmailVerifiedStmt: %w", cerr)
+		}
+	}
+	if q.markOutboxPublishedStmt != nil {
+		if cerr := q.markOutboxPublishedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markOutboxPublishedStmt: %w", cerr)
+		}
+	}
+	if q.patchUserStmt != nil {
+		if cerr := q.patchUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing patchUserStmt: %w", cerr)
+		}
+	}
+	if q.purgeDeletedUsersStmt != nil {
+		if cerr := q.purgeDeletedUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing purgeDeletedUsersStmt: %w", cerr)
+		}
+	}
+	if q.purgeUserStmt != nil {
+		if cerr := q.purgeUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing purgeUserStmt: %w", cerr)
+		}
+	}
+	if q.redeliverWebhookDeliveryStmt != nil {
+		if cerr := q.redeliverWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing redeliverWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.restoreUserStmt != nil {
+		if cerr := q.restoreUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing restoreUserStmt: %w", cerr)
+		}
+	}
+	if q.revokeAPIKeyStmt != nil {
+		if cerr := q.revokeAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenStmt != nil {
+		if cerr := q.revokeRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenFamilyStmt != nil {
+		if cerr := q.revokeRefreshTokenFamilyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenFamilyStmt: %w", cerr)
+		}
+	}
+	if q.revokeSessionStmt != nil {
+		if cerr := q.revokeSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeSessionStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserRefreshTokensStmt != nil {
+		if cerr := q.revokeUserRefreshTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserRefreshTokensStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserSessionsStmt != nil {
+		if cerr := q.revokeUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.sessionActiveStmt != nil {
+		if cerr := q.sessionActiveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sessionActiveStmt: %w", cerr)
+		}
+	}
+	if q.takeRateLimitTokenStmt != nil {
+		if cerr := q.takeRateLimitTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing takeRateLimitTokenStmt: %w", cerr)
+		}
+	}
+	if q.touchAPIKeyStmt != nil {
+		if cerr := q.touchAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.touchSessionStmt != nil {
+		if cerr := q.touchSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchSessionStmt: %w", cerr)
+		}
+	}
+	if q.touchUserIdentityStmt != nil {
+		if cerr := q.touchUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.updatePasswordHashStmt != nil {
+		if cerr := q.updatePasswordHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePasswordHashStmt: %w", cerr)
+		}
+	}
+	if q.updateTOTPAttemptsStmt != nil {
+		if cerr := q.updateTOTPAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTOTPAttemptsStmt: %w", cerr)
+		}
+	}
 	if q.updateUserStmt != nil {
 		if cerr := q.updateUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserStmt: %w", cerr)
 		}
 	}
+	if q.updateWebhookStmt != nil {
+		if cerr := q.updateWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookStmt: %w", cerr)
+		}
+	}
+	if q.updateWebhookDeliveryStmt != nil {
+		if cerr := q.updateWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.upsertUserTOTPStmt != nil {
+		if cerr := q.upsertUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.useEmailTokenStmt != nil {
+		if cerr := q.useEmailTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useEmailTokenStmt: %w", cerr)
+		}
+	}
+	if q.useRecoveryCodeStmt != nil {
+		if cerr := q.useRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useRecoveryCodeStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -98,21 +674,165 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db             DBTX
-	tx             *sql.Tx
-	deleteUserStmt *sql.Stmt
-	getUserStmt    *sql.Stmt
-	insertUserStmt *sql.Stmt
-	updateUserStmt *sql.Stmt
+	db                                DBTX
+	tx                                *sql.Tx
+	claimWebhookDeliveriesStmt        *sql.Stmt
+	confirmUserTOTPStmt               *sql.Stmt
+	countRecoveryCodesStmt            *sql.Stmt
+	countUsersStmt                    *sql.Stmt
+	deleteExpiredIdempotencyKeysStmt  *sql.Stmt
+	deleteExpiredRateLimitBucketsStmt *sql.Stmt
+	deleteRecoveryCodesStmt           *sql.Stmt
+	deleteUserStmt                    *sql.Stmt
+	deleteUserRoleStmt                *sql.Stmt
+	deleteUserTOTPStmt                *sql.Stmt
+	deleteWebhookStmt                 *sql.Stmt
+	enqueueWebhookDeliveriesStmt      *sql.Stmt
+	fetchOutboxBatchStmt              *sql.Stmt
+	getAPIKeyStmt                     *sql.Stmt
+	getAPIKeyByPrefixStmt             *sql.Stmt
+	getCredentialsByLoginStmt         *sql.Stmt
+	getIdempotencyKeyStmt             *sql.Stmt
+	getRefreshTokenForUpdateStmt      *sql.Stmt
+	getUserStmt                       *sql.Stmt
+	getUserByEmailStmt                *sql.Stmt
+	getUserForUpdateStmt              *sql.Stmt
+	getUserIdentityStmt               *sql.Stmt
+	getUserRolesStmt                  *sql.Stmt
+	getUserTOTPStmt                   *sql.Stmt
+	getUserTOTPForUpdateStmt          *sql.Stmt
+	getWebhookStmt                    *sql.Stmt
+	getWebhookDeliveryStmt            *sql.Stmt
+	insertAPIKeyStmt                  *sql.Stmt
+	insertCredentialsStmt             *sql.Stmt
+	insertEmailTokenStmt              *sql.Stmt
+	insertIdempotencyKeyStmt          *sql.Stmt
+	insertOutboxEventStmt             *sql.Stmt
+	insertRecoveryCodeStmt            *sql.Stmt
+	insertRefreshTokenStmt            *sql.Stmt
+	insertSessionStmt                 *sql.Stmt
+	insertUserStmt                    *sql.Stmt
+	insertUserAuditStmt               *sql.Stmt
+	insertUserIdentityStmt            *sql.Stmt
+	insertUserRoleStmt                *sql.Stmt
+	insertWebhookStmt                 *sql.Stmt
+	insertWebhookAttemptStmt          *sql.Stmt
+	invalidateEmailTokensStmt         *sql.Stmt
+	listAPIKeysStmt                   *sql.Stmt
+	listUserAuditStmt                 *sql.Stmt
+	listUserSessionsStmt              *sql.Stmt
+	listUsersAscStmt                  *sql.Stmt
+	listUsersDescStmt                 *sql.Stmt
+	listWebhookAttemptsStmt           *sql.Stmt
+	listWebhookDeliveriesStmt         *sql.Stmt
+	listWebhooksStmt                  *sql.Stmt
+	markEmailVerifiedStmt             *sql.Stmt
+	markOutboxPublishedStmt           *sql.Stmt
+	patchUserStmt                     *sql.Stmt
+	purgeDeletedUsersStmt             *sql.Stmt
+	purgeUserStmt                     *sql.Stmt
+	redeliverWebhookDeliveryStmt      *sql.Stmt
+	restoreUserStmt                   *sql.Stmt
+	revokeAPIKeyStmt                  *sql.Stmt
+	revokeRefreshTokenStmt            *sql.Stmt
+	revokeRefreshTokenFamilyStmt      *sql.Stmt
+	revokeSessionStmt                 *sql.Stmt
+	revokeUserRefreshTokensStmt       *sql.Stmt
+	revokeUserSessionsStmt            *sql.Stmt
+	sessionActiveStmt                 *sql.Stmt
+	takeRateLimitTokenStmt            *sql.Stmt
+	touchAPIKeyStmt                   *sql.Stmt
+	touchSessionStmt                  *sql.Stmt
+	touchUserIdentityStmt             *sql.Stmt
+	updatePasswordHashStmt            *sql.Stmt
+	updateTOTPAttemptsStmt            *sql.Stmt
+	updateUserStmt                    *sql.Stmt
+	updateWebhookStmt                 *sql.Stmt
+	updateWebhookDeliveryStmt         *sql.Stmt
+	upsertUserTOTPStmt                *sql.Stmt
+	useEmailTokenStmt                 *sql.Stmt
+	useRecoveryCodeStmt               *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:             tx,
-		tx:             tx,
-		deleteUserStmt: q.deleteUserStmt,
-		getUserStmt:    q.getUserStmt,
-		insertUserStmt: q.insertUserStmt,
-		updateUserStmt: q.updateUserStmt,
+		db:                                tx,
+		tx:                                tx,
+		claimWebhookDeliveriesStmt:        q.claimWebhookDeliveriesStmt,
+		confirmUserTOTPStmt:               q.confirmUserTOTPStmt,
+		countRecoveryCodesStmt:            q.countRecoveryCodesStmt,
+		countUsersStmt:                    q.countUsersStmt,
+		deleteExpiredIdempotencyKeysStmt:  q.deleteExpiredIdempotencyKeysStmt,
+		deleteExpiredRateLimitBucketsStmt: q.deleteExpiredRateLimitBucketsStmt,
+		deleteRecoveryCodesStmt:           q.deleteRecoveryCodesStmt,
+		deleteUserStmt:                    q.deleteUserStmt,
+		deleteUserRoleStmt:                q.deleteUserRoleStmt,
+		deleteUserTOTPStmt:                q.deleteUserTOTPStmt,
+		deleteWebhookStmt:                 q.deleteWebhookStmt,
+		enqueueWebhookDeliveriesStmt:      q.enqueueWebhookDeliveriesStmt,
+		fetchOutboxBatchStmt:              q.fetchOutboxBatchStmt,
+		getAPIKeyStmt:                     q.getAPIKeyStmt,
+		getAPIKeyByPrefixStmt:             q.getAPIKeyByPrefixStmt,
+		getCredentialsByLoginStmt:         q.getCredentialsByLoginStmt,
+		getIdempotencyKeyStmt:             q.getIdempotencyKeyStmt,
+		getRefreshTokenForUpdateStmt:      q.getRefreshTokenForUpdateStmt,
+		getUserStmt:                       q.getUserStmt,
+		getUserByEmailStmt:                q.getUserByEmailStmt,
+		getUserForUpdateStmt:              q.getUserForUpdateStmt,
+		getUserIdentityStmt:               q.getUserIdentityStmt,
+		getUserRolesStmt:                  q.getUserRolesStmt,
+		getUserTOTPStmt:                   q.getUserTOTPStmt,
+		getUserTOTPForUpdateStmt:          q.getUserTOTPForUpdateStmt,
+		getWebhookStmt:                    q.getWebhookStmt,
+		getWebhookDeliveryStmt:            q.getWebhookDeliveryStmt,
+		insertAPIKeyStmt:                  q.insertAPIKeyStmt,
+		insertCredentialsStmt:             q.insertCredentialsStmt,
+		insertEmailTokenStmt:              q.insertEmailTokenStmt,
+		insertIdempotencyKeyStmt:          q.insertIdempotencyKeyStmt,
+		insertOutboxEventStmt:             q.insertOutboxEventStmt,
+		insertRecoveryCodeStmt:            q.insertRecoveryCodeStmt,
+		insertRefreshTokenStmt:            q.insertRefreshTokenStmt,
+		insertSessionStmt:                 q.insertSessionStmt,
+		insertUserStmt:                    q.insertUserStmt,
+		insertUserAuditStmt:               q.insertUserAuditStmt,
+		insertUserIdentityStmt:            q.insertUserIdentityStmt,
+		insertUserRoleStmt:                q.insertUserRoleStmt,
+		insertWebhookStmt:                 q.insertWebhookStmt,
+		insertWebhookAttemptStmt:          q.insertWebhookAttemptStmt,
+		invalidateEmailTokensStmt:         q.invalidateEmailTokensStmt,
+		listAPIKeysStmt:                   q.listAPIKeysStmt,
+		listUserAuditStmt:                 q.listUserAuditStmt,
+		listUserSessionsStmt:              q.listUserSessionsStmt,
+		listUsersAscStmt:                  q.listUsersAscStmt,
+		listUsersDescStmt:                 q.listUsersDescStmt,
+		listWebhookAttemptsStmt:           q.listWebhookAttemptsStmt,
+		listWebhookDeliveriesStmt:         q.listWebhookDeliveriesStmt,
+		listWebhooksStmt:                  q.listWebhooksStmt,
+		markEmailVerifiedStmt:             q.markEmailVerifiedStmt,
+		markOutboxPublishedStmt:           q.markOutboxPublishedStmt,
+		patchUserStmt:                     q.patchUserStmt,
+		purgeDeletedUsersStmt:             q.purgeDeletedUsersStmt,
+		purgeUserStmt:                     q.purgeUserStmt,
+		redeliverWebhookDeliveryStmt:      q.redeliverWebhookDeliveryStmt,
+		restoreUserStmt:                   q.restoreUserStmt,
+		revokeAPIKeyStmt:                  q.revokeAPIKeyStmt,
+		revokeRefreshTokenStmt:            q.revokeRefreshTokenStmt,
+		revokeRefreshTokenFamilyStmt:      q.revokeRefreshTokenFamilyStmt,
+		revokeSessionStmt:                 q.revokeSessionStmt,
+		revokeUserRefreshTokensStmt:       q.revokeUserRefreshTokensStmt,
+		revokeUserSessionsStmt:            q.revokeUserSessionsStmt,
+		sessionActiveStmt:                 q.sessionActiveStmt,
+		takeRateLimitTokenStmt:            q.takeRateLimitTokenStmt,
+		touchAPIKeyStmt:                   q.touchAPIKeyStmt,
+		touchSessionStmt:                  q.touchSessionStmt,
+		touchUserIdentityStmt:             q.touchUserIdentityStmt,
+		updatePasswordHashStmt:            q.updatePasswordHashStmt,
+		updateTOTPAttemptsStmt:            q.updateTOTPAttemptsStmt,
+		updateUserStmt:                    q.updateUserStmt,
+		updateWebhookStmt:                 q.updateWebhookStmt,
+		updateWebhookDeliveryStmt:         q.updateWebhookDeliveryStmt,
+		upsertUserTOTPStmt:                q.upsertUserTOTPStmt,
+		useEmailTokenStmt:                 q.useEmailTokenStmt,
+		useRecoveryCodeStmt:               q.useRecoveryCodeStmt,
 	}
 }
@@ -0,0 +1,16 @@
+-- name: InsertIdempotencyKey :execrows
+-- Ключ с истекшим сроком, который еще не удален очисткой, занимается заново.
+INSERT INTO idempotency_keys (principal, key, request_hash, user_guid, expires_at)
+VALUES (@principal, @key, @request_hash, @user_guid, now() + make_interval(secs => @ttl_seconds::float8))
+ON CONFLICT (principal, key) DO UPDATE SET
+    request_hash = EXCLUDED.request_hash,
+    user_guid = EXCLUDED.user_guid,
+    created_at = now(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < now();
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys WHERE principal = @principal AND key = @key;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at < now();
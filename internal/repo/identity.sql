@@ -0,0 +1,12 @@
+-- name: GetUserIdentity :one
+SELECT i.user_guid, u.is_deleted
+FROM user_identities i
+JOIN users u ON u.guid = i.user_guid
+WHERE i.provider = @provider AND i.subject = @subject;
+
+-- name: InsertUserIdentity :exec
+INSERT INTO user_identities (provider, subject, user_guid, email) VALUES ($1, $2, $3, $4);
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities SET email = @email, last_login_at = now()
+WHERE provider = @provider AND subject = @subject;
@@ -0,0 +1,38 @@
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp WHERE user_guid = @user_guid;
+
+-- name: GetUserTOTPForUpdate :one
+SELECT * FROM user_totp WHERE user_guid = @user_guid FOR UPDATE;
+
+-- name: UpsertUserTOTP :execrows
+INSERT INTO user_totp (user_guid, secret) VALUES ($1, $2)
+ON CONFLICT (user_guid) DO UPDATE SET
+    secret = EXCLUDED.secret,
+    last_used_step = 0,
+    failed_attempts = 0,
+    locked_until = NULL,
+    created_at = now()
+WHERE user_totp.confirmed_at IS NULL;
+
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totp SET confirmed_at = now() WHERE user_guid = @user_guid AND confirmed_at IS NULL;
+
+-- name: UpdateTOTPAttempts :exec
+UPDATE user_totp SET last_used_step = @last_used_step, failed_attempts = @failed_attempts, locked_until = @locked_until
+WHERE user_guid = @user_guid;
+
+-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totp WHERE user_guid = @user_guid;
+
+-- name: InsertRecoveryCode :exec
+INSERT INTO user_recovery_codes (user_guid, code_hash) VALUES ($1, $2);
+
+-- name: UseRecoveryCode :execrows
+UPDATE user_recovery_codes SET used_at = now()
+WHERE user_guid = @user_guid AND code_hash = @code_hash AND used_at IS NULL;
+
+-- name: CountRecoveryCodes :one
+SELECT count(*) FROM user_recovery_codes WHERE user_guid = @user_guid AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes WHERE user_guid = @user_guid;
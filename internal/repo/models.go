@@ -5,11 +5,120 @@
 package query
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
+type ApiKey struct {
+	// Идентификатор ключа, субъект запросов с этим ключом
+	ID uuid.UUID
+	// Название ключа, например имя сервиса-клиента
+	Name string
+	// Открытое начало ключа, по нему ключ находится при проверке
+	Prefix string
+	// SHA-256 ключа целиком в hex
+	KeyHash string
+	// Разрешения ключа: users:read, users:write, admin
+	Scopes []string
+	// Субъект, выпустивший ключ: GUID администратора или идентификатор ключа API
+	CreatedBy uuid.NullUUID
+	// Дата выпуска
+	CreatedAt time.Time
+	// Срок действия, пустой - бессрочный
+	ExpiresAt sql.NullTime
+	// Дата последнего использования с точностью до минуты
+	LastUsedAt sql.NullTime
+	// Дата отзыва
+	RevokedAt sql.NullTime
+}
+
+type EmailToken struct {
+	// SHA-256 хэш токена из письма
+	TokenHash string
+	// GUID пользователя
+	UserGuid uuid.UUID
+	// Назначение: verify_email или reset_password
+	Purpose string
+	// Адрес, на который отправлен токен
+	Email string
+	// Срок действия
+	ExpiresAt time.Time
+	// Дата использования или замены новым токеном
+	UsedAt sql.NullTime
+	// Дата создания
+	CreatedAt time.Time
+}
+
+type IdempotencyKey struct {
+	// Значение заголовка Idempotency-Key
+	Key string
+	// Хэш тела запроса, с которым был использован ключ
+	RequestHash string
+	// GUID созданного пользователя
+	UserGuid uuid.UUID
+	// Дата создания
+	CreatedAt time.Time
+	// GUID пользователя или идентификатор ключа API, использовавшего ключ; ключи разных субъектов независимы
+	Principal uuid.UUID
+	// Срок действия ключа, после него ключ можно использовать повторно
+	ExpiresAt time.Time
+}
+
+type Outbox struct {
+	// Порядковый номер события, определяет порядок публикации
+	ID int64
+	// Идентификатор события для дедупликации у получателей
+	EventID uuid.UUID
+	// GUID пользователя, к которому относится событие
+	AggregateID uuid.UUID
+	// Тип события, например user.created
+	EventType string
+	// Тело события в JSON
+	Payload json.RawMessage
+	// Дата создания
+	CreatedAt time.Time
+	// Дата публикации, NULL для неопубликованных
+	PublishedAt sql.NullTime
+}
+
+type RefreshToken struct {
+	// SHA-256 хэш refresh token
+	TokenHash string
+	// Идентификатор сессии, общий для всей цепочки ротаций
+	FamilyID uuid.UUID
+	// GUID пользователя
+	UserGuid uuid.UUID
+	// Срок действия
+	ExpiresAt time.Time
+	// Дата отзыва или использования
+	RevokedAt sql.NullTime
+	// Дата создания
+	CreatedAt time.Time
+}
+
+type Session struct {
+	// Идентификатор сессии, совпадает с refresh_tokens.family_id
+	ID uuid.UUID
+	// GUID пользователя
+	UserGuid uuid.UUID
+	// User-Agent клиента при входе
+	UserAgent string
+	// IP адрес клиента при последнем обращении
+	Ip string
+	// Дата входа
+	CreatedAt time.Time
+	// Дата последнего обновления токенов
+	LastSeenAt time.Time
+	// Срок действия последнего refresh token
+	ExpiresAt time.Time
+	// Дата отзыва или выхода
+	RevokedAt sql.NullTime
+}
+
 type User struct {
 	// GUID пользователя
 	Guid uuid.UUID
@@ -23,4 +132,125 @@ type User struct {
 	CreatedAt time.Time
 	// Дата обновления
 	UpdatedAt time.Time
+	// Версия записи для оптимистичной блокировки
+	Version int64
+	// Дата удаления
+	DeletedAt sql.NullTime
+	// GUID пользователя или идентификатор ключа API, выполнившего удаление
+	DeletedBy uuid.NullUUID
+	// Имя пользователя для входа и отображения, уникально без учета регистра
+	Username sql.NullString
+	// Адрес электронной почты, уникален без учета регистра
+	Email sql.NullString
+	// Телефон в формате E.164
+	Phone sql.NullString
+	// Имя
+	FirstName sql.NullString
+	// Фамилия
+	LastName sql.NullString
+	// Дата рождения
+	BirthDate sql.NullTime
+	// Дата подтверждения текущего email, сбрасывается при его смене
+	EmailVerifiedAt sql.NullTime
+	// Тип субъекта удаления: user или api_key
+	DeletedByType sql.NullString
+}
+
+type UserAudit struct {
+	// Порядковый номер записи
+	ID int64
+	// GUID измененного пользователя, без внешнего ключа, чтобы история переживала физическое удаление
+	UserGuid uuid.UUID
+	// GUID пользователя или идентификатор ключа API, выполнившего изменение, NULL для системных операций
+	Actor uuid.NullUUID
+	// Тип изменения
+	Action string
+	// Состояние пользователя до изменения
+	Before pqtype.NullRawMessage
+	// Состояние пользователя после изменения
+	After pqtype.NullRawMessage
+	// Идентификатор запроса из X-Request-Id
+	RequestID sql.NullString
+	// Дата изменения
+	CreatedAt time.Time
+	// Тип субъекта изменения: user или api_key, NULL для системных операций
+	ActorType sql.NullString
+	// Подробности изменения, не видные по состоянию пользователя, например выданная роль
+	Details pqtype.NullRawMessage
+}
+
+type UserTotp struct {
+	// GUID пользователя
+	UserGuid uuid.UUID
+	// Секрет TOTP в base32
+	Secret string
+	// Дата подтверждения первым кодом, до нее второй фактор не запрашивается
+	ConfirmedAt sql.NullTime
+	// Последний принятый 30-секундный интервал, код из него повторно не принимается
+	LastUsedStep int64
+	// Неверных кодов подряд
+	FailedAttempts int32
+	// До этой даты коды не проверяются после превышения числа попыток
+	LockedUntil sql.NullTime
+	// Дата выпуска секрета
+	CreatedAt time.Time
+}
+
+type Webhook struct {
+	// Идентификатор подписки
+	ID uuid.UUID
+	// GUID пользователя, создавшего подписку
+	OwnerID uuid.UUID
+	// Адрес, на который отправляются события
+	Url string
+	// Типы событий, пустой массив - все события
+	EventTypes []string
+	// Ключ подписи HMAC-SHA256
+	Secret string
+	// Признак активной подписки, неактивным события не отправляются
+	Active bool
+	// Дата создания
+	CreatedAt time.Time
+	// Дата обновления
+	UpdatedAt time.Time
+}
+
+type WebhookAttempt struct {
+	// Порядковый номер попытки
+	ID int64
+	// Доставка, к которой относится попытка
+	DeliveryID int64
+	// HTTP статус ответа, NULL если ответ не получен
+	StatusCode sql.NullInt32
+	// Ошибка попытки, NULL для успешной
+	Error sql.NullString
+	// Длительность запроса в миллисекундах
+	DurationMs int64
+	// Дата попытки
+	AttemptedAt time.Time
+}
+
+type WebhookDelivery struct {
+	// Порядковый номер доставки
+	ID int64
+	// Подписка, которой доставляется событие
+	WebhookID uuid.UUID
+	// Идентификатор события из outbox
+	EventID uuid.UUID
+	// Тип события, например user.created
+	EventType string
+	// Тело события в JSON
+	Payload json.RawMessage
+	// Состояние доставки: pending, delivered или dead после исчерпания попыток
+	Status string
+	// Количество выполненных попыток
+	Attempts int32
+	// Время следующей попытки
+	NextAttemptAt time.Time
+	// Ошибка последней попытки
+	LastError sql.NullString
+	// Дата создания
+	CreatedAt time.Time
+	// Дата успешной доставки
+	DeliveredAt sql.NullTime
 }
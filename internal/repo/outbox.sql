@@ -0,0 +1,12 @@
+-- name: InsertOutboxEvent :exec
+INSERT INTO outbox (event_id, aggregate_id, event_type, payload) VALUES (@event_id, @aggregate_id, @event_type, @payload);
+
+-- name: FetchOutboxBatch :many
+SELECT * FROM outbox
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxPublished :exec
+UPDATE outbox SET published_at = now() WHERE id = ANY(@ids::bigint[]);
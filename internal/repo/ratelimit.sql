@@ -0,0 +1,23 @@
+-- name: TakeRateLimitToken :one
+-- Пополняет бакет с момента прошлого списания и списывает токен, если он есть. Строка блокируется
+-- на время upsert, поэтому параллельные запросы разных экземпляров не списывают один токен дважды.
+-- Если токена нет, возвращается текущий остаток с allowed = false.
+WITH taken AS (
+    INSERT INTO rate_limit_buckets AS b (key, tokens, updated_at, expires_at)
+    VALUES (@key, @burst::float8 - 1, now(), now() + make_interval(secs => @burst::float8 / @rate::float8))
+    ON CONFLICT (key) DO UPDATE
+    SET tokens     = LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8) - 1,
+        updated_at = now(),
+        expires_at = now() + make_interval(secs => @burst::float8 / @rate::float8)
+    WHERE LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8) >= 1
+    RETURNING b.tokens, true AS allowed
+)
+SELECT taken.tokens, taken.allowed FROM taken
+UNION ALL
+SELECT LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8) AS tokens,
+       false AS allowed
+FROM rate_limit_buckets b
+WHERE b.key = @key AND NOT EXISTS (SELECT 1 FROM taken);
+
+-- name: DeleteExpiredRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets WHERE expires_at < now();
@@ -0,0 +1,24 @@
+-- name: InsertSession :exec
+INSERT INTO sessions (id, user_guid, user_agent, ip, expires_at) VALUES ($1, $2, $3, $4, $5);
+
+-- name: TouchSession :execrows
+UPDATE sessions SET ip = @ip, last_seen_at = now(), expires_at = @expires_at
+WHERE id = @id AND revoked_at IS NULL;
+
+-- name: ListUserSessions :many
+SELECT * FROM sessions
+WHERE user_guid = @user_guid AND revoked_at IS NULL AND expires_at > now()
+ORDER BY last_seen_at DESC, id;
+
+-- name: SessionActive :one
+SELECT EXISTS (
+    SELECT 1 FROM sessions
+    WHERE id = @id AND user_guid = @user_guid AND revoked_at IS NULL AND expires_at > now()
+);
+
+-- name: RevokeSession :execrows
+UPDATE sessions SET revoked_at = now()
+WHERE id = @id AND user_guid = @user_guid AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :execrows
+UPDATE sessions SET revoked_at = now() WHERE user_guid = @user_guid AND revoked_at IS NULL;
@@ -1,11 +1,111 @@
 -- name: GetUser :one
 SELECT * FROM users WHERE guid = @guid;
 
--- name: InsertUser :exec
-INSERT INTO users (guid, name, occupation, created_at, updated_at) VALUES ($1, $2, $3, now(), now());
+-- name: GetUserForUpdate :one
+SELECT * FROM users WHERE guid = @guid FOR UPDATE;
 
--- name: UpdateUser :exec
-UPDATE users SET name = @name, occupation = @occupation, updated_at = now() WHERE guid = @guid;
+-- name: InsertUser :one
+INSERT INTO users (guid, name, occupation, username, email, phone, first_name, last_name, birth_date, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now()) RETURNING *;
 
--- name: DeleteUser :exec
-UPDATE users SET is_deleted = true, updated_at = now() WHERE guid = @guid;
\ No newline at end of file
+-- name: UpdateUser :execrows
+UPDATE users SET
+    name = @name,
+    occupation = @occupation,
+    username = @username,
+    email = @email,
+    phone = @phone,
+    first_name = @first_name,
+    last_name = @last_name,
+    birth_date = @birth_date,
+    email_verified_at = CASE WHEN lower(@email) = lower(email) THEN email_verified_at END,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = @guid
+  AND NOT is_deleted
+  AND (sqlc.narg('version')::bigint IS NULL OR version = sqlc.narg('version'));
+
+-- name: PatchUser :execrows
+-- PatchUser меняет необязательное поле, только если передан его флаг set_*: значение NULL очищает поле.
+UPDATE users SET
+    name = COALESCE(sqlc.narg('name'), name),
+    occupation = COALESCE(sqlc.narg('occupation'), occupation),
+    username = CASE WHEN @set_username::boolean THEN sqlc.narg('username')::text ELSE username END,
+    email = CASE WHEN @set_email::boolean THEN sqlc.narg('email')::text ELSE email END,
+    phone = CASE WHEN @set_phone::boolean THEN sqlc.narg('phone')::text ELSE phone END,
+    first_name = CASE WHEN @set_first_name::boolean THEN sqlc.narg('first_name')::text ELSE first_name END,
+    last_name = CASE WHEN @set_last_name::boolean THEN sqlc.narg('last_name')::text ELSE last_name END,
+    birth_date = CASE WHEN @set_birth_date::boolean THEN sqlc.narg('birth_date')::date ELSE birth_date END,
+    email_verified_at = CASE WHEN NOT @set_email::boolean OR lower(sqlc.narg('email')) = lower(email) THEN email_verified_at END,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = @guid
+  AND NOT is_deleted
+  AND (sqlc.narg('version')::bigint IS NULL OR version = sqlc.narg('version'));
+
+-- name: DeleteUser :execrows
+UPDATE users SET
+    is_deleted = true,
+    deleted_at = now(),
+    deleted_by = sqlc.narg('deleted_by'),
+    deleted_by_type = sqlc.narg('deleted_by_type'),
+    updated_at = now(),
+    version = version + 1
+WHERE guid = @guid
+  AND NOT is_deleted
+  AND (sqlc.narg('version')::bigint IS NULL OR version = sqlc.narg('version'));
+
+-- name: RestoreUser :one
+UPDATE users SET
+    is_deleted = false,
+    deleted_at = NULL,
+    deleted_by = NULL,
+    deleted_by_type = NULL,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = @guid AND is_deleted
+RETURNING *;
+
+-- name: PurgeUser :execrows
+DELETE FROM users WHERE guid = @guid;
+
+-- name: PurgeDeletedUsers :many
+DELETE FROM users WHERE guid IN (
+    SELECT guid FROM users
+    WHERE is_deleted AND deleted_at < @deleted_before::timestamptz
+    ORDER BY deleted_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ListUsersDesc :many
+SELECT * FROM users
+WHERE (sqlc.narg('name')::text IS NULL OR name ILIKE '%' || sqlc.narg('name') || '%')
+  AND (sqlc.narg('occupation')::text IS NULL OR occupation ILIKE '%' || sqlc.narg('occupation') || '%')
+  AND (sqlc.narg('is_deleted')::boolean IS NULL OR is_deleted = sqlc.narg('is_deleted'))
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, guid) < (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_guid')::uuid))
+ORDER BY created_at DESC, guid DESC
+LIMIT @row_limit;
+
+-- name: ListUsersAsc :many
+SELECT * FROM users
+WHERE (sqlc.narg('name')::text IS NULL OR name ILIKE '%' || sqlc.narg('name') || '%')
+  AND (sqlc.narg('occupation')::text IS NULL OR occupation ILIKE '%' || sqlc.narg('occupation') || '%')
+  AND (sqlc.narg('is_deleted')::boolean IS NULL OR is_deleted = sqlc.narg('is_deleted'))
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, guid) > (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_guid')::uuid))
+ORDER BY created_at ASC, guid ASC
+LIMIT @row_limit;
+
+-- name: CountUsers :one
+-- CountUsers считает пользователей, подходящих под фильтр, но не больше row_cap:
+-- подсчет останавливается на row_cap строках, а не проходит всю таблицу.
+SELECT count(*) FROM (
+    SELECT 1 FROM users
+    WHERE (sqlc.narg('name')::text IS NULL OR name ILIKE '%' || sqlc.narg('name') || '%')
+      AND (sqlc.narg('occupation')::text IS NULL OR occupation ILIKE '%' || sqlc.narg('occupation') || '%')
+      AND (sqlc.narg('is_deleted')::boolean IS NULL OR is_deleted = sqlc.narg('is_deleted'))
+    LIMIT @row_cap
+) AS capped;
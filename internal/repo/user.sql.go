@@ -7,21 +7,78 @@ package query
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-const deleteUser = `-- name: DeleteUser :exec
-UPDATE users SET is_deleted = true, updated_at = now() WHERE guid = $1
+const countUsers = `-- name: CountUsers :one
+SELECT count(*) FROM (
+    SELECT 1 FROM users
+    WHERE ($1::text IS NULL OR name ILIKE '%' || $1 || '%')
+      AND ($2::text IS NULL OR occupation ILIKE '%' || $2 || '%')
+      AND ($3::boolean IS NULL OR is_deleted = $3)
+    LIMIT $4
+) AS capped
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, guid uuid.UUID) error {
-	_, err := q.exec(ctx, q.deleteUserStmt, deleteUser, guid)
-	return err
+type CountUsersParams struct {
+	Name       sql.NullString
+	Occupation sql.NullString
+	IsDeleted  sql.NullBool
+	RowCap     int32
+}
+
+// CountUsers считает пользователей, подходящих под фильтр, но не больше row_cap:
+// подсчет останавливается на row_cap строках, а не проходит всю таблицу.
+func (q *Queries) CountUsers(ctx context.Context, arg CountUsersParams) (int64, error) {
+	row := q.queryRow(ctx, q.countUsersStmt, countUsers,
+		arg.Name,
+		arg.Occupation,
+		arg.IsDeleted,
+		arg.RowCap,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+UPDATE users SET
+    is_deleted = true,
+    deleted_at = now(),
+    deleted_by = $1,
+    deleted_by_type = $2,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = $3
+  AND NOT is_deleted
+  AND ($4::bigint IS NULL OR version = $4)
+`
+
+type DeleteUserParams struct {
+	DeletedBy     uuid.NullUUID
+	DeletedByType sql.NullString
+	Guid          uuid.UUID
+	Version       sql.NullInt64
+}
+
+func (q *Queries) DeleteUser(ctx context.Context, arg DeleteUserParams) (int64, error) {
+	result, err := q.exec(ctx, q.deleteUserStmt, deleteUser,
+		arg.DeletedBy,
+		arg.DeletedByType,
+		arg.Guid,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getUser = `-- name: GetUser :one
-SELECT guid, name, occupation, is_deleted, created_at, updated_at FROM users WHERE guid = $1
+SELECT guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type FROM users WHERE guid = $1
 `
 
 func (q *Queries) GetUser(ctx context.Context, guid uuid.UUID) (User, error) {
@@ -34,36 +91,453 @@ func (q *Queries) GetUser(ctx context.Context, guid uuid.UUID) (User, error) {
 		&i.IsDeleted,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.Username,
+		&i.Email,
+		&i.Phone,
+		&i.FirstName,
+		&i.LastName,
+		&i.BirthDate,
+		&i.EmailVerifiedAt,
+		&i.DeletedByType,
 	)
 	return i, err
 }
 
-const insertUser = `-- name: InsertUser :exec
-INSERT INTO users (guid, name, occupation, created_at, updated_at) VALUES ($1, $2, $3, now(), now())
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type FROM users WHERE guid = $1 FOR UPDATE
+`
+
+func (q *Queries) GetUserForUpdate(ctx context.Context, guid uuid.UUID) (User, error) {
+	row := q.queryRow(ctx, q.getUserForUpdateStmt, getUserForUpdate, guid)
+	var i User
+	err := row.Scan(
+		&i.Guid,
+		&i.Name,
+		&i.Occupation,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.Username,
+		&i.Email,
+		&i.Phone,
+		&i.FirstName,
+		&i.LastName,
+		&i.BirthDate,
+		&i.EmailVerifiedAt,
+		&i.DeletedByType,
+	)
+	return i, err
+}
+
+const insertUser = `-- name: InsertUser :one
+INSERT INTO users (guid, name, occupation, username, email, phone, first_name, last_name, birth_date, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now()) RETURNING guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type
 `
 
 type InsertUserParams struct {
 	Guid       uuid.UUID
 	Name       string
 	Occupation string
+	Username   sql.NullString
+	Email      sql.NullString
+	Phone      sql.NullString
+	FirstName  sql.NullString
+	LastName   sql.NullString
+	BirthDate  sql.NullTime
+}
+
+func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (User, error) {
+	row := q.queryRow(ctx, q.insertUserStmt, insertUser,
+		arg.Guid,
+		arg.Name,
+		arg.Occupation,
+		arg.Username,
+		arg.Email,
+		arg.Phone,
+		arg.FirstName,
+		arg.LastName,
+		arg.BirthDate,
+	)
+	var i User
+	err := row.Scan(
+		&i.Guid,
+		&i.Name,
+		&i.Occupation,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.Username,
+		&i.Email,
+		&i.Phone,
+		&i.FirstName,
+		&i.LastName,
+		&i.BirthDate,
+		&i.EmailVerifiedAt,
+		&i.DeletedByType,
+	)
+	return i, err
+}
+
+const listUsersAsc = `-- name: ListUsersAsc :many
+SELECT guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type FROM users
+WHERE ($1::text IS NULL OR name ILIKE '%' || $1 || '%')
+  AND ($2::text IS NULL OR occupation ILIKE '%' || $2 || '%')
+  AND ($3::boolean IS NULL OR is_deleted = $3)
+  AND ($4::timestamptz IS NULL
+    OR (created_at, guid) > ($4, $5::uuid))
+ORDER BY created_at ASC, guid ASC
+LIMIT $6
+`
+
+type ListUsersAscParams struct {
+	Name            sql.NullString
+	Occupation      sql.NullString
+	IsDeleted       sql.NullBool
+	CursorCreatedAt sql.NullTime
+	CursorGuid      uuid.NullUUID
+	RowLimit        int32
 }
 
-func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) error {
-	_, err := q.exec(ctx, q.insertUserStmt, insertUser, arg.Guid, arg.Name, arg.Occupation)
-	return err
+func (q *Queries) ListUsersAsc(ctx context.Context, arg ListUsersAscParams) ([]User, error) {
+	rows, err := q.query(ctx, q.listUsersAscStmt, listUsersAsc,
+		arg.Name,
+		arg.Occupation,
+		arg.IsDeleted,
+		arg.CursorCreatedAt,
+		arg.CursorGuid,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Guid,
+			&i.Name,
+			&i.Occupation,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.Username,
+			&i.Email,
+			&i.Phone,
+			&i.FirstName,
+			&i.LastName,
+			&i.BirthDate,
+			&i.EmailVerifiedAt,
+			&i.DeletedByType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateUser = `-- name: UpdateUser :exec
-UPDATE users SET name = $1, occupation = $2, updated_at = now() WHERE guid = $3
+const listUsersDesc = `-- name: ListUsersDesc :many
+SELECT guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type FROM users
+WHERE ($1::text IS NULL OR name ILIKE '%' || $1 || '%')
+  AND ($2::text IS NULL OR occupation ILIKE '%' || $2 || '%')
+  AND ($3::boolean IS NULL OR is_deleted = $3)
+  AND ($4::timestamptz IS NULL
+    OR (created_at, guid) < ($4, $5::uuid))
+ORDER BY created_at DESC, guid DESC
+LIMIT $6
+`
+
+type ListUsersDescParams struct {
+	Name            sql.NullString
+	Occupation      sql.NullString
+	IsDeleted       sql.NullBool
+	CursorCreatedAt sql.NullTime
+	CursorGuid      uuid.NullUUID
+	RowLimit        int32
+}
+
+func (q *Queries) ListUsersDesc(ctx context.Context, arg ListUsersDescParams) ([]User, error) {
+	rows, err := q.query(ctx, q.listUsersDescStmt, listUsersDesc,
+		arg.Name,
+		arg.Occupation,
+		arg.IsDeleted,
+		arg.CursorCreatedAt,
+		arg.CursorGuid,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Guid,
+			&i.Name,
+			&i.Occupation,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.Username,
+			&i.Email,
+			&i.Phone,
+			&i.FirstName,
+			&i.LastName,
+			&i.BirthDate,
+			&i.EmailVerifiedAt,
+			&i.DeletedByType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const patchUser = `-- name: PatchUser :execrows
+UPDATE users SET
+    name = COALESCE($1, name),
+    occupation = COALESCE($2, occupation),
+    username = CASE WHEN $3::boolean THEN $4::text ELSE username END,
+    email = CASE WHEN $5::boolean THEN $6::text ELSE email END,
+    phone = CASE WHEN $7::boolean THEN $8::text ELSE phone END,
+    first_name = CASE WHEN $9::boolean THEN $10::text ELSE first_name END,
+    last_name = CASE WHEN $11::boolean THEN $12::text ELSE last_name END,
+    birth_date = CASE WHEN $13::boolean THEN $14::date ELSE birth_date END,
+    email_verified_at = CASE WHEN NOT $5::boolean OR lower($6) = lower(email) THEN email_verified_at END,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = $15
+  AND NOT is_deleted
+  AND ($16::bigint IS NULL OR version = $16)
+`
+
+type PatchUserParams struct {
+	Name         sql.NullString
+	Occupation   sql.NullString
+	SetUsername  bool
+	Username     sql.NullString
+	SetEmail     bool
+	Email        sql.NullString
+	SetPhone     bool
+	Phone        sql.NullString
+	SetFirstName bool
+	FirstName    sql.NullString
+	SetLastName  bool
+	LastName     sql.NullString
+	SetBirthDate bool
+	BirthDate    sql.NullTime
+	Guid         uuid.UUID
+	Version      sql.NullInt64
+}
+
+// PatchUser меняет необязательное поле, только если передан его флаг set_*: значение NULL очищает поле.
+func (q *Queries) PatchUser(ctx context.Context, arg PatchUserParams) (int64, error) {
+	result, err := q.exec(ctx, q.patchUserStmt, patchUser,
+		arg.Name,
+		arg.Occupation,
+		arg.SetUsername,
+		arg.Username,
+		arg.SetEmail,
+		arg.Email,
+		arg.SetPhone,
+		arg.Phone,
+		arg.SetFirstName,
+		arg.FirstName,
+		arg.SetLastName,
+		arg.LastName,
+		arg.SetBirthDate,
+		arg.BirthDate,
+		arg.Guid,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const purgeDeletedUsers = `-- name: PurgeDeletedUsers :many
+DELETE FROM users WHERE guid IN (
+    SELECT guid FROM users
+    WHERE is_deleted AND deleted_at < $1::timestamptz
+    ORDER BY deleted_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type
+`
+
+type PurgeDeletedUsersParams struct {
+	DeletedBefore time.Time
+	BatchSize     int32
+}
+
+func (q *Queries) PurgeDeletedUsers(ctx context.Context, arg PurgeDeletedUsersParams) ([]User, error) {
+	rows, err := q.query(ctx, q.purgeDeletedUsersStmt, purgeDeletedUsers, arg.DeletedBefore, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Guid,
+			&i.Name,
+			&i.Occupation,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.Username,
+			&i.Email,
+			&i.Phone,
+			&i.FirstName,
+			&i.LastName,
+			&i.BirthDate,
+			&i.EmailVerifiedAt,
+			&i.DeletedByType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeUser = `-- name: PurgeUser :execrows
+DELETE FROM users WHERE guid = $1
+`
+
+func (q *Queries) PurgeUser(ctx context.Context, guid uuid.UUID) (int64, error) {
+	result, err := q.exec(ctx, q.purgeUserStmt, purgeUser, guid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreUser = `-- name: RestoreUser :one
+UPDATE users SET
+    is_deleted = false,
+    deleted_at = NULL,
+    deleted_by = NULL,
+    deleted_by_type = NULL,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = $1 AND is_deleted
+RETURNING guid, name, occupation, is_deleted, created_at, updated_at, version, deleted_at, deleted_by, username, email, phone, first_name, last_name, birth_date, email_verified_at, deleted_by_type
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, guid uuid.UUID) (User, error) {
+	row := q.queryRow(ctx, q.restoreUserStmt, restoreUser, guid)
+	var i User
+	err := row.Scan(
+		&i.Guid,
+		&i.Name,
+		&i.Occupation,
+		&i.IsDeleted,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.Username,
+		&i.Email,
+		&i.Phone,
+		&i.FirstName,
+		&i.LastName,
+		&i.BirthDate,
+		&i.EmailVerifiedAt,
+		&i.DeletedByType,
+	)
+	return i, err
+}
+
+const updateUser = `-- name: UpdateUser :execrows
+UPDATE users SET
+    name = $1,
+    occupation = $2,
+    username = $3,
+    email = $4,
+    phone = $5,
+    first_name = $6,
+    last_name = $7,
+    birth_date = $8,
+    email_verified_at = CASE WHEN lower($4) = lower(email) THEN email_verified_at END,
+    updated_at = now(),
+    version = version + 1
+WHERE guid = $9
+  AND NOT is_deleted
+  AND ($10::bigint IS NULL OR version = $10)
 `
 
 type UpdateUserParams struct {
 	Name       string
 	Occupation string
+	Username   sql.NullString
+	Email      sql.NullString
+	Phone      sql.NullString
+	FirstName  sql.NullString
+	LastName   sql.NullString
+	BirthDate  sql.NullTime
 	Guid       uuid.UUID
+	Version    sql.NullInt64
 }
 
-func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
-	_, err := q.exec(ctx, q.updateUserStmt, updateUser, arg.Name, arg.Occupation, arg.Guid)
-	return err
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateUserStmt, updateUser,
+		arg.Name,
+		arg.Occupation,
+		arg.Username,
+		arg.Email,
+		arg.Phone,
+		arg.FirstName,
+		arg.LastName,
+		arg.BirthDate,
+		arg.Guid,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
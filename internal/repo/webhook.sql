@@ -0,0 +1,78 @@
+-- name: InsertWebhook :one
+INSERT INTO webhooks (id, owner_id, url, event_types, secret, active)
+VALUES (@id, @owner_id, @url, @event_types, @secret, @active)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM webhooks
+WHERE id = @id AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id'));
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+WHERE sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id')
+ORDER BY created_at, id;
+
+-- name: UpdateWebhook :one
+UPDATE webhooks SET
+    url = @url,
+    event_types = @event_types,
+    secret = COALESCE(sqlc.narg('secret'), secret),
+    active = @active,
+    updated_at = now()
+WHERE id = @id AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id'))
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = @id AND (sqlc.narg('owner_id')::uuid IS NULL OR owner_id = sqlc.narg('owner_id'));
+
+-- name: EnqueueWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+SELECT id, @event_id::uuid, @event_type::text, @payload::jsonb FROM webhooks
+WHERE active AND (cardinality(event_types) = 0 OR @event_type::text = ANY(event_types));
+
+-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries d SET next_attempt_at = @lease_until::timestamptz
+FROM webhooks w
+WHERE w.id = d.webhook_id
+  AND d.id IN (
+    SELECT wd.id FROM webhook_deliveries wd
+    JOIN webhooks wh ON wh.id = wd.webhook_id
+    WHERE wd.status = 'pending' AND wd.next_attempt_at <= now() AND wh.active
+    ORDER BY wd.next_attempt_at, wd.id
+    LIMIT @batch_size
+    FOR UPDATE OF wd SKIP LOCKED
+  )
+RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.attempts, w.url, w.secret;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries SET
+    status = @status,
+    attempts = @attempts,
+    next_attempt_at = @next_attempt_at,
+    last_error = sqlc.narg('last_error'),
+    delivered_at = sqlc.narg('delivered_at')
+WHERE id = @id;
+
+-- name: InsertWebhookAttempt :exec
+INSERT INTO webhook_attempts (delivery_id, status_code, error, duration_ms)
+VALUES (@delivery_id, sqlc.narg('status_code'), sqlc.narg('error'), @duration_ms);
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = @id AND webhook_id = @webhook_id;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = @webhook_id
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id'))
+ORDER BY id DESC
+LIMIT @row_limit;
+
+-- name: ListWebhookAttempts :many
+SELECT * FROM webhook_attempts WHERE delivery_id = ANY(@delivery_ids::bigint[]) ORDER BY delivery_id, id;
+
+-- name: RedeliverWebhookDelivery :one
+UPDATE webhook_deliveries SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+WHERE id = @id AND webhook_id = @webhook_id AND status = 'dead'
+RETURNING *;
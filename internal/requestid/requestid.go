@@ -0,0 +1,46 @@
+// Package requestid прокидывает идентификатор запроса из заголовка X-Request-Id в контекст и логи.
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const Header = "X-Request-Id"
+
+// maxLength ограничивает длину принятого от клиента идентификатора.
+const maxLength = 128
+
+type ctxKey struct{}
+
+// FromContext возвращает идентификатор запроса или пустую строку.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+
+	return id
+}
+
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// Middleware берет идентификатор из X-Request-Id или генерирует новый, возвращает его в ответе
+// и добавляет в контекст логгера.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if id == "" || len(id) > maxLength {
+			id = uuid.NewString()
+		}
+
+		w.Header().Set(Header, id)
+
+		ctx := WithID(r.Context(), id)
+		logger := zerolog.Ctx(ctx).With().Str("request_id", id).Logger()
+
+		next.ServeHTTP(w, r.WithContext(logger.WithContext(ctx)))
+	})
+}
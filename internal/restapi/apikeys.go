@@ -0,0 +1,122 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/restapi/operations/api_keys"
+	"otusgruz/internal/service/api/auth"
+)
+
+const (
+	headerAPIKey = "X-API-Key"
+	// schemeAPIKey имя схемы ключей API в спецификации.
+	schemeAPIKey = "APIKey"
+)
+
+// apiKeyAuthenticator заменяет стандартный APIKeyAuth для схемы APIKey: проверка ключа обращается к базе,
+// поэтому ей нужен контекст запроса, а сбой базы отличается от неверного ключа.
+func apiKeyAuthenticator(keys auth.APIKeyAuthenticator) runtime.Authenticator {
+	return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+		key := r.Header.Get(headerAPIKey)
+		if key == "" {
+			return false, nil, nil
+		}
+
+		principal, err := keys.AuthenticateAPIKey(r.Context(), key)
+		if err != nil {
+			return true, nil, authError(r.Context(), err)
+		}
+
+		return true, principal, nil
+	})
+}
+
+// authError ответ на ошибку проверки токена или ключа: 401 для недействительных, 500 при сбое проверки.
+func authError(ctx context.Context, err error) error {
+	if errorCode(err) == CodeUnauthorized {
+		return errors.New(http.StatusUnauthorized, "%s", err.Error())
+	}
+
+	zerolog.Ctx(ctx).Err(err).Msg("authentication failed")
+
+	return errors.New(http.StatusInternalServerError, internalErrorMessage)
+}
+
+func (h *Handler) ListAPIKeys(params api_keys.GetAPIKeysParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.authSrv.ListAPIKeys(ctx)
+	if err != nil {
+		return api_keys.NewGetAPIKeysInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return api_keys.NewGetAPIKeysOK().WithPayload(res)
+}
+
+func (h *Handler) CreateAPIKey(params api_keys.PostAPIKeysParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.authSrv.CreateAPIKey(ctx, principalOf(principal).Subject, params.Request)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeValidation:
+			return api_keys.NewPostAPIKeysUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return api_keys.NewPostAPIKeysInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	location := (&api_keys.GetAPIKeysIDURL{ID: res.ID}).String() //nolint:exhaustruct
+
+	return api_keys.NewPostAPIKeysCreated().WithLocation(location).WithPayload(res)
+}
+
+func (h *Handler) GetAPIKey(params api_keys.GetAPIKeysIDParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return api_keys.NewGetAPIKeysIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.GetAPIKey(ctx, id)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return api_keys.NewGetAPIKeysIDNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return api_keys.NewGetAPIKeysIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return api_keys.NewGetAPIKeysIDOK().WithPayload(res)
+}
+
+func (h *Handler) RevokeAPIKey(params api_keys.DeleteAPIKeysIDParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return api_keys.NewDeleteAPIKeysIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	if err = h.authSrv.RevokeAPIKey(ctx, id); err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return api_keys.NewDeleteAPIKeysIDNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return api_keys.NewDeleteAPIKeysIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return api_keys.NewDeleteAPIKeysIDOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "API key revoked"})
+}
@@ -0,0 +1,152 @@
+package restapi
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/restapi/operations/auth"
+)
+
+func (h *Handler) Register(params auth.PostAuthRegisterParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.authSrv.Register(ctx, params.Request, h.client(params.HTTPRequest))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeConflict:
+			return auth.NewPostAuthRegisterConflict().WithPayload(apiError(ctx, err))
+		case CodeValidation:
+			return auth.NewPostAuthRegisterUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthRegisterInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	cookies, err := h.cookies.issue(res)
+	if err != nil {
+		return auth.NewPostAuthRegisterInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(auth.NewPostAuthRegisterCreated().WithPayload(res), cookies)
+}
+
+func (h *Handler) Login(params auth.PostAuthLoginParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, challenge, err := h.authSrv.Login(ctx, params.Request, h.client(params.HTTPRequest))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeUnauthorized:
+			return auth.NewPostAuthLoginUnauthorized().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthLoginInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	if challenge != nil {
+		return auth.NewPostAuthLoginAccepted().WithPayload(challenge)
+	}
+
+	cookies, err := h.cookies.issue(res)
+	if err != nil {
+		return auth.NewPostAuthLoginInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(auth.NewPostAuthLoginOK().WithPayload(res), cookies)
+}
+
+func (h *Handler) Refresh(params auth.PostAuthRefreshParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	token, err := h.cookies.refreshToken(params.HTTPRequest, params.Request)
+	if err != nil {
+		return auth.NewPostAuthRefreshForbidden().WithPayload(apiError(ctx, err))
+	}
+
+	res, err := h.authSrv.Refresh(ctx, token, h.client(params.HTTPRequest))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeUnauthorized:
+			return auth.NewPostAuthRefreshUnauthorized().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthRefreshInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	cookies, err := h.cookies.issue(res)
+	if err != nil {
+		return auth.NewPostAuthRefreshInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(auth.NewPostAuthRefreshOK().WithPayload(res), cookies)
+}
+
+func (h *Handler) Logout(params auth.PostAuthLogoutParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	token, err := h.cookies.refreshToken(params.HTTPRequest, params.Request)
+	if err != nil {
+		return auth.NewPostAuthLogoutForbidden().WithPayload(apiError(ctx, err))
+	}
+
+	if err = h.authSrv.Logout(ctx, token); err != nil {
+		return auth.NewPostAuthLogoutInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(
+		auth.NewPostAuthLogoutOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Successfully logged out"}),
+		h.cookies.clear(),
+	)
+}
+
+func (h *Handler) VerifyEmail(params auth.PostAuthVerifyEmailParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authSrv.VerifyEmail(ctx, params.Request.Token); err != nil {
+		switch errorCode(err) {
+		case CodeEmailToken:
+			return auth.NewPostAuthVerifyEmailBadRequest().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthVerifyEmailInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return auth.NewPostAuthVerifyEmailOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Email verified"})
+}
+
+func (h *Handler) ResendVerification(params auth.PostAuthVerifyEmailResendParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authSrv.ResendVerification(ctx, params.Request.Email.String()); err != nil {
+		return auth.NewPostAuthVerifyEmailResendInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return auth.NewPostAuthVerifyEmailResendAccepted().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Accepted"})
+}
+
+func (h *Handler) ForgotPassword(params auth.PostAuthPasswordForgotParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authSrv.ForgotPassword(ctx, params.Request.Email.String()); err != nil {
+		return auth.NewPostAuthPasswordForgotInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return auth.NewPostAuthPasswordForgotAccepted().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Accepted"})
+}
+
+func (h *Handler) ResetPassword(params auth.PostAuthPasswordResetParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	if err := h.authSrv.ResetPassword(ctx, params.Request.Token, params.Request.Password.String()); err != nil {
+		switch errorCode(err) {
+		case CodeEmailToken:
+			return auth.NewPostAuthPasswordResetBadRequest().WithPayload(apiError(ctx, err))
+		case CodeValidation:
+			return auth.NewPostAuthPasswordResetUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthPasswordResetInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return auth.NewPostAuthPasswordResetOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Password changed"})
+}
@@ -0,0 +1,137 @@
+package restapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/service/api/auth"
+	"otusgruz/internal/service/api/user"
+)
+
+// rule решает, разрешена ли операция субъекту. params содержит path параметры маршрута.
+type rule func(principal *auth.Principal, params middleware.RouteParams) bool
+
+// policy правила доступа к защищенным операциям, ключ - метод и путь из спецификации.
+// Операции, отсутствующие в таблице, запрещены.
+var policy = map[string]rule{
+	http.MethodGet + " /user":           hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodPost + " /user":          hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodGet + " /user/{guid}":    ownerOrAdmin,
+	http.MethodPatch + " /user/{guid}":  ownerOrAdmin,
+	http.MethodPut + " /user/{guid}":    ownerOrAdmin,
+	http.MethodDelete + " /user/{guid}": ownerOrAdmin,
+
+	http.MethodGet + " /user/{guid}/history":  ownerOrAdmin,
+	http.MethodPost + " /user/{guid}/restore": hasRole(auth.RoleAdmin),
+
+	http.MethodGet + " /user/{guid}/sessions":         ownerOrAdmin,
+	http.MethodDelete + " /user/{guid}/sessions/{id}": ownerOrAdmin,
+
+	// подключить второй фактор и получить его секреты может только сам пользователь
+	http.MethodGet + " /user/{guid}/mfa":                 ownerOrAdmin,
+	http.MethodPost + " /user/{guid}/mfa/totp":           owner,
+	http.MethodDelete + " /user/{guid}/mfa/totp":         ownerOrAdmin,
+	http.MethodGet + " /user/{guid}/mfa/totp/qr":         owner,
+	http.MethodPost + " /user/{guid}/mfa/totp/confirm":   owner,
+	http.MethodPost + " /user/{guid}/mfa/recovery-codes": owner,
+
+	// подписки получают данные всех пользователей, поэтому доступны только admin и service
+	http.MethodGet + " /webhooks":                                          hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodPost + " /webhooks":                                         hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodGet + " /webhooks/{id}":                                     hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodPut + " /webhooks/{id}":                                     hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodDelete + " /webhooks/{id}":                                  hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodGet + " /webhooks/{id}/deliveries":                          hasRole(auth.RoleAdmin, auth.RoleService),
+	http.MethodPost + " /webhooks/{id}/deliveries/{delivery_id}/redeliver": hasRole(auth.RoleAdmin, auth.RoleService),
+
+	http.MethodGet + " /api-keys":         hasRole(auth.RoleAdmin),
+	http.MethodPost + " /api-keys":        hasRole(auth.RoleAdmin),
+	http.MethodGet + " /api-keys/{id}":    hasRole(auth.RoleAdmin),
+	http.MethodDelete + " /api-keys/{id}": hasRole(auth.RoleAdmin),
+}
+
+// apiKeyPolicy операции, которые ключ API выполняет по scope, в дополнение к policy.
+// Ключ со scope admin получает роль admin и проходит правила policy, остальные ключи ролей не имеют.
+var apiKeyPolicy = map[string]string{
+	http.MethodGet + " /user":                auth.ScopeUsersRead,
+	http.MethodGet + " /user/{guid}":         auth.ScopeUsersRead,
+	http.MethodGet + " /user/{guid}/history": auth.ScopeUsersRead,
+	http.MethodPost + " /user":               auth.ScopeUsersWrite,
+	http.MethodPatch + " /user/{guid}":       auth.ScopeUsersWrite,
+	http.MethodPut + " /user/{guid}":         auth.ScopeUsersWrite,
+	http.MethodDelete + " /user/{guid}":      auth.ScopeUsersWrite,
+}
+
+func hasRole(roles ...string) rule {
+	return func(principal *auth.Principal, _ middleware.RouteParams) bool {
+		return principal.HasRole(roles...)
+	}
+}
+
+func owner(principal *auth.Principal, params middleware.RouteParams) bool {
+	return strings.EqualFold(params.Get("guid"), principal.Subject.String())
+}
+
+func ownerOrAdmin(principal *auth.Principal, params middleware.RouteParams) bool {
+	return principal.HasRole(auth.RoleAdmin) || strings.EqualFold(params.Get("guid"), principal.Subject.String())
+}
+
+// principalOf приводит principal go-swagger к *auth.Principal. Для неизвестного типа возвращает субъекта без ролей.
+func principalOf(principal interface{}) *auth.Principal {
+	if p, ok := principal.(*auth.Principal); ok {
+		return p
+	}
+
+	return &auth.Principal{} //nolint:exhaustruct
+}
+
+// actorOf субъект изменения для журнала: ключ API или пользователь. Для неизвестного principal - системная операция.
+func actorOf(principal interface{}) user.Actor {
+	p := principalOf(principal)
+
+	switch {
+	case p.APIKeyID != uuid.Nil:
+		return user.APIKeyActor(p.APIKeyID)
+	case p.Subject != uuid.Nil:
+		return user.UserActor(p.Subject)
+	default:
+		return user.Actor{} //nolint:exhaustruct
+	}
+}
+
+type authorizer struct{}
+
+// Authorize реализует runtime.Authorizer и вызывается go-swagger после аутентификации.
+func (authorizer) Authorize(r *http.Request, principal interface{}) error {
+	p, ok := principal.(*auth.Principal)
+	if !ok {
+		return errors.New(http.StatusForbidden, "unsupported principal")
+	}
+
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil {
+		return errors.New(http.StatusForbidden, "route is not resolved")
+	}
+
+	operation := operationKey(r, route)
+
+	if scope, ok := apiKeyPolicy[operation]; ok && p.APIKeyID != uuid.Nil && p.HasScope(scope) {
+		return nil
+	}
+
+	allow, ok := policy[operation]
+	if !ok || !allow(p, route.Params) {
+		return errors.New(http.StatusForbidden, "access denied")
+	}
+
+	return nil
+}
+
+// operationKey метод и путь операции из спецификации, ключ таблиц policy и лимитов запросов.
+func operationKey(r *http.Request, route *middleware.MatchedRoute) string {
+	return r.Method + " " + strings.TrimPrefix(route.PathPattern, route.BasePath)
+}
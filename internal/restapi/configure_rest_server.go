@@ -3,15 +3,19 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
+	"strings"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
 
 	"otusgruz/internal/restapi/operations"
 	"otusgruz/internal/restapi/operations/other"
+	"otusgruz/internal/service/api/auth"
 )
 
 //go:generate swagger generate server --target ../../internal --name RestServer --spec ../../api/swagger/file.yaml --principal interface{} --exclude-main --exclude-spec
@@ -36,8 +40,37 @@ func configureAPI(api *operations.RestServerAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
 
+	// Applies when the "X-API-Key" header is set
+	if api.APIKeyAuth == nil {
+		api.APIKeyAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (APIKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		}
+	}
+
+	// Applies when the "Authorization" header is set
+	if api.BearerAuth == nil {
+		api.BearerAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		}
+	}
+
+	// Applies when the "Cookie" header is set
+	if api.CookieSessionAuth == nil {
+		api.CookieSessionAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (CookieSession) Cookie from header param [Cookie] has not yet been implemented")
+		}
+	}
+
+	// Applies when the "X-User-Id" header is set
+	if api.TrustedProxyAuth == nil {
+		api.TrustedProxyAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (TrustedProxy) X-User-Id from header param [X-User-Id] has not yet been implemented")
+		}
+	}
+
 	if api.OtherGetHealthHandler == nil {
 		api.OtherGetHealthHandler = other.GetHealthHandlerFunc(func(params other.GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation other.GetHealth has not yet been implemented")
@@ -51,6 +84,54 @@ func configureAPI(api *operations.RestServerAPI) http.Handler {
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
+// ConfigureAuth подключает проверку access token к схемам Bearer и CookieSession, ключей API к схеме APIKey,
+// заголовки edge-аутентификации к схеме TrustedProxy и политику доступа. Возвращаемый principal имеет тип *auth.Principal.
+func ConfigureAuth(api *operations.RestServerAPI, authSrv auth.Service, trusted TrustedProxy, cookies CookieSession) {
+	api.APIAuthorizer = authorizer{}
+
+	api.TrustedProxyAuth = func(string) (interface{}, error) {
+		return nil, errors.New(http.StatusUnauthorized, "trusted proxy headers are not accepted")
+	}
+
+	// не вызывается: схема CookieSession обрабатывается cookies.authenticator
+	api.CookieSessionAuth = func(string) (interface{}, error) {
+		return nil, errors.New(http.StatusUnauthorized, "cookie sessions are disabled")
+	}
+
+	// не вызывается: схема APIKey обрабатывается apiKeyAuthenticator
+	api.APIKeyAuth = func(string) (interface{}, error) {
+		return nil, errors.New(http.StatusUnauthorized, "api keys are not accepted")
+	}
+
+	defaultAuthenticator := api.APIKeyAuthenticator
+	api.APIKeyAuthenticator = func(name, in string, fn security.TokenAuthentication) runtime.Authenticator {
+		switch {
+		case name == HeaderUserID && trusted.Enabled:
+			return trusted.authenticator()
+		case name == headerCookie:
+			return cookies.authenticator(authSrv)
+		case name == headerAPIKey:
+			return apiKeyAuthenticator(authSrv)
+		default:
+			return defaultAuthenticator(name, in, fn)
+		}
+	}
+
+	api.BearerAuth = func(header string) (interface{}, error) {
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok {
+			return nil, errors.New(http.StatusUnauthorized, "authorization header must use Bearer scheme")
+		}
+
+		principal, err := authSrv.Authenticate(context.Background(), token)
+		if err != nil {
+			return nil, authError(context.Background(), err)
+		}
+
+		return principal, nil
+	}
+}
+
 // The TLS configuration before HTTPS server starts.
 func configureTLS(tlsConfig *tls.Config) {
 	// Make all necessary changes to the TLS configuration here.
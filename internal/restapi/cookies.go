@@ -0,0 +1,219 @@
+package restapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/service/api/auth"
+)
+
+// Cookie и заголовки режима cookie.
+const (
+	CookieAccessToken  = "access_token"
+	CookieRefreshToken = "refresh_token"
+	CookieCSRFToken    = "csrf_token"
+	HeaderCSRFToken    = "X-CSRF-Token"
+	// CookieOIDCFlow состояние входа через OIDC провайдер, выставляется в любом режиме.
+	CookieOIDCFlow = "oidc_flow"
+
+	// headerCookie имя заголовка схемы CookieSession в спецификации.
+	headerCookie = "Cookie"
+)
+
+// CookieSession настройки режима, в котором токены передаются в HttpOnly cookie,
+// а не в теле ответа и заголовке Authorization.
+type CookieSession struct {
+	Enabled  bool
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string
+	// Path базовый путь API. Refresh token отправляется браузером только на <Path>/auth.
+	Path       string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// issue переносит токены из ответа в cookie и добавляет новый CSRF токен. Вне режима cookie ничего не делает.
+func (c CookieSession) issue(tokens *models.AuthTokens) ([]*http.Cookie, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	csrf, err := newCSRFToken()
+	if err != nil {
+		return nil, err
+	}
+
+	cookies := []*http.Cookie{
+		c.cookie(CookieAccessToken, tokens.AccessToken, c.Path, c.AccessTTL, true),
+		c.cookie(CookieRefreshToken, tokens.RefreshToken, c.Path+"/auth", c.RefreshTTL, true),
+		// CSRF токен читает JavaScript фронтенда, поэтому он доступен на всем сайте
+		c.cookie(CookieCSRFToken, csrf, "/", c.RefreshTTL, false),
+	}
+
+	tokens.AccessToken = ""
+	tokens.RefreshToken = ""
+
+	return cookies, nil
+}
+
+// clear удаляет cookie сессии. Вне режима cookie ничего не делает.
+func (c CookieSession) clear() []*http.Cookie {
+	if !c.Enabled {
+		return nil
+	}
+
+	return []*http.Cookie{
+		c.cookie(CookieAccessToken, "", c.Path, -1, true),
+		c.cookie(CookieRefreshToken, "", c.Path+"/auth", -1, true),
+		c.cookie(CookieCSRFToken, "", "/", -1, false),
+	}
+}
+
+// oidcFlow cookie попытки входа через OIDC провайдер. SameSite=Lax, потому что браузер
+// возвращается от провайдера переходом с другого сайта и cookie со Strict не отправил бы.
+func (c CookieSession) oidcFlow(value string, ttl time.Duration) *http.Cookie {
+	cookie := c.cookie(CookieOIDCFlow, value, c.Path+"/auth/oidc", ttl, true)
+	cookie.SameSite = http.SameSiteLaxMode
+
+	return cookie
+}
+
+// cookie при отрицательном ttl формирует cookie для удаления.
+func (c CookieSession) cookie(name, value, path string, ttl time.Duration, httpOnly bool) *http.Cookie {
+	maxAge := int(ttl.Seconds())
+	if ttl < 0 {
+		maxAge = -1
+	}
+
+	return &http.Cookie{ //nolint:exhaustruct
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   c.Domain,
+		MaxAge:   maxAge,
+		Secure:   c.Secure,
+		HttpOnly: httpOnly,
+		SameSite: c.SameSite,
+	}
+}
+
+// refreshToken берет refresh token из тела запроса, а при его отсутствии в режиме cookie - из cookie.
+// Cookie отправляются браузером автоматически, поэтому в этом случае запрос проходит проверку CSRF.
+func (c CookieSession) refreshToken(r *http.Request, body *models.RefreshParams) (string, error) {
+	if body != nil && body.RefreshToken != "" {
+		return body.RefreshToken, nil
+	}
+
+	if !c.Enabled {
+		return "", nil
+	}
+
+	cookie, err := r.Cookie(CookieRefreshToken)
+	if err != nil {
+		return "", nil //nolint:nilerr
+	}
+
+	if err = checkCSRF(r); err != nil {
+		return "", err
+	}
+
+	return cookie.Value, nil
+}
+
+// accessToken берет access token из cookie. found false, если режим cookie выключен или cookie нет.
+// Изменяющий запрос без верного X-CSRF-Token отклоняется с errCSRF.
+func (c CookieSession) accessToken(r *http.Request) (token string, found bool, err error) {
+	if !c.Enabled {
+		return "", false, nil
+	}
+
+	cookie, err := r.Cookie(CookieAccessToken)
+	if err != nil || cookie.Value == "" {
+		return "", false, nil
+	}
+
+	if err = checkCSRF(r); err != nil {
+		return "", true, err
+	}
+
+	return cookie.Value, true, nil
+}
+
+// authenticator заменяет стандартный APIKeyAuth для схемы CookieSession: access token берется
+// из cookie, а изменяющие запросы должны содержать X-CSRF-Token.
+func (c CookieSession) authenticator(authn auth.Authenticator) runtime.Authenticator {
+	return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+		token, found, err := c.accessToken(r)
+		if !found {
+			return false, nil, nil
+		}
+
+		if err != nil {
+			return true, nil, errors.New(http.StatusForbidden, "%s", err.Error())
+		}
+
+		principal, err := authn.Authenticate(r.Context(), token)
+		if err != nil {
+			return true, nil, authError(r.Context(), err)
+		}
+
+		return true, principal, nil
+	})
+}
+
+// checkCSRF сверяет заголовок X-CSRF-Token с cookie csrf_token (double submit cookie).
+// Запросы, не изменяющие данные, не проверяются.
+func checkCSRF(r *http.Request) error {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+
+	cookie, err := r.Cookie(CookieCSRFToken)
+	if err != nil || cookie.Value == "" {
+		return errCSRF
+	}
+
+	header := r.Header.Get(HeaderCSRFToken)
+	if subtle.ConstantTimeCompare([]byte(header), []byte(cookie.Value)) != 1 {
+		return errCSRF
+	}
+
+	return nil
+}
+
+func newCSRFToken() (string, error) {
+	buf := make([]byte, 32) //nolint:mnd
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate csrf token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// withCookies выставляет cookie перед ответом responder.
+func withCookies(responder middleware.Responder, cookies []*http.Cookie) middleware.Responder {
+	if len(cookies) == 0 {
+		return responder
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		for _, cookie := range cookies {
+			http.SetCookie(w, cookie)
+		}
+
+		responder.WriteResponse(w, p)
+	})
+}
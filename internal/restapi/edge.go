@@ -0,0 +1,149 @@
+package restapi
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/security"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"otusgruz/internal/service/api/auth"
+)
+
+// Заголовки, которыми edge-аутентификация передает субъекта в upstream.
+const (
+	HeaderUserID    = "X-User-Id"
+	HeaderUserRoles = "X-User-Roles"
+)
+
+// NewEdgeAuthHandler обработчик для nginx auth-request: 200 с X-User-Id и X-User-Roles
+// для валидного access token, иначе 401. Токен берется из заголовка Authorization, а в режиме cookie
+// при его отсутствии - из cookie сессии, как в схеме CookieSession, с той же проверкой CSRF.
+func NewEdgeAuthHandler(authn auth.Authenticator, cookies CookieSession) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			var err error
+
+			token, ok, err = cookies.accessToken(r)
+			if err != nil {
+				zerolog.Ctx(r.Context()).Debug().Err(err).Msg("edge auth rejected")
+				w.WriteHeader(http.StatusForbidden)
+
+				return
+			}
+		}
+
+		if !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		principal, err := authn.Authenticate(r.Context(), token)
+		if err != nil {
+			zerolog.Ctx(r.Context()).Debug().Err(err).Msg("edge auth rejected")
+
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set(HeaderUserID, principal.Subject.String())
+		w.Header().Set(HeaderUserRoles, strings.Join(principal.Roles, ","))
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// TrustedProxy настройки доверия заголовкам edge-аутентификации.
+type TrustedProxy struct {
+	Enabled bool
+	// Networks адреса прокси. Пустой список не доверяет никому: иначе заголовки мог бы подставить любой клиент.
+	Networks []*net.IPNet
+}
+
+func (t TrustedProxy) trusts(r *http.Request) bool {
+	if len(t.Networks) == 0 {
+		return false
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+
+	return t.contains(net.ParseIP(host))
+}
+
+func (t TrustedProxy) contains(ip net.IP) bool {
+	for _, network := range t.Networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clientIP адрес клиента. X-Forwarded-For учитывается только для запроса от доверенного прокси:
+// адресом клиента считается последний адрес цепочки, не принадлежащий доверенным сетям.
+func (t TrustedProxy) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+
+	if !t.Enabled || !t.trusts(r) {
+		return host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if ip == nil {
+			break
+		}
+
+		if !t.contains(ip) {
+			return ip.String()
+		}
+	}
+
+	return host
+}
+
+// authenticator читает оба заголовка, поэтому заменяет стандартный APIKeyAuth для схемы TrustedProxy.
+func (t TrustedProxy) authenticator() runtime.Authenticator {
+	return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+		rawID := r.Header.Get(HeaderUserID)
+		if rawID == "" {
+			return false, nil, nil
+		}
+
+		if !t.trusts(r) {
+			return true, nil, errors.New(http.StatusUnauthorized, "request is not from a trusted proxy")
+		}
+
+		subject, err := uuid.Parse(rawID)
+		if err != nil {
+			return true, nil, errors.New(http.StatusUnauthorized, "invalid %s header", HeaderUserID)
+		}
+
+		var roles []string
+
+		for _, role := range strings.Split(r.Header.Get(HeaderUserRoles), ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+
+		return true, &auth.Principal{Subject: subject, Roles: roles}, nil //nolint:exhaustruct
+	})
+}
@@ -0,0 +1,123 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"otusgruz/internal/service/api/auth"
+)
+
+const validToken = "valid-token"
+
+// tokenAuthenticator принимает только validToken.
+type tokenAuthenticator struct {
+	principal *auth.Principal
+}
+
+func (a tokenAuthenticator) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	if token != validToken {
+		return nil, errors.New("invalid token")
+	}
+
+	return a.principal, nil
+}
+
+func TestEdgeAuthHandler(t *testing.T) {
+	principal := &auth.Principal{Subject: uuid.New(), Roles: []string{"user", "admin"}} //nolint:exhaustruct
+
+	tests := []struct {
+		name       string
+		cookieMode bool
+		method     string
+		header     string
+		cookies    []*http.Cookie
+		csrf       string
+		wantStatus int
+	}{
+		{name: "bearer", method: http.MethodGet, header: "Bearer " + validToken, wantStatus: http.StatusOK},
+		{name: "invalid bearer", method: http.MethodGet, header: "Bearer forged", wantStatus: http.StatusUnauthorized},
+		{name: "no credentials", cookieMode: true, method: http.MethodGet, wantStatus: http.StatusUnauthorized},
+		{
+			name:       "session cookie",
+			cookieMode: true,
+			method:     http.MethodGet,
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: validToken}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "session cookie with csrf token",
+			cookieMode: true,
+			method:     http.MethodPost,
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: validToken}, {Name: CookieCSRFToken, Value: "csrf"}},
+			csrf:       "csrf",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "session cookie without csrf token",
+			cookieMode: true,
+			method:     http.MethodPost,
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: validToken}, {Name: CookieCSRFToken, Value: "csrf"}},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid session cookie",
+			cookieMode: true,
+			method:     http.MethodGet,
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: "forged"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "session cookie in bearer mode",
+			method:     http.MethodGet,
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: validToken}},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewEdgeAuthHandler(tokenAuthenticator{principal: principal}, CookieSession{Enabled: tt.cookieMode}) //nolint:exhaustruct
+
+			req := httptest.NewRequest(tt.method, "/auth", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			if tt.csrf != "" {
+				req.Header.Set(HeaderCSRFToken, tt.csrf)
+			}
+
+			for _, cookie := range tt.cookies {
+				req.AddCookie(cookie)
+			}
+
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+
+			if rw.Code != tt.wantStatus {
+				t.Fatalf("status %d, want %d", rw.Code, tt.wantStatus)
+			}
+
+			if tt.wantStatus != http.StatusOK {
+				if rw.Header().Get(HeaderUserID) != "" {
+					t.Errorf("%s set for rejected request", HeaderUserID)
+				}
+
+				return
+			}
+
+			if got := rw.Header().Get(HeaderUserID); got != principal.Subject.String() {
+				t.Errorf("%s %q, want %s", HeaderUserID, got, principal.Subject)
+			}
+
+			if got := rw.Header().Get(HeaderUserRoles); got != "user,admin" {
+				t.Errorf("%s %q, want user,admin", HeaderUserRoles, got)
+			}
+		})
+	}
+}
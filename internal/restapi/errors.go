@@ -0,0 +1,130 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/service/api/auth"
+	"otusgruz/internal/service/api/user"
+	"otusgruz/internal/service/api/webhook"
+)
+
+// Коды ошибок API, перечислены в описании поля code определения Error.
+const (
+	CodeInternal        int64 = 1
+	CodeBadRequest      int64 = 2
+	CodeNotFound        int64 = 3
+	CodeAlreadyDeleted  int64 = 4
+	CodeConflict        int64 = 5
+	CodeValidation      int64 = 6
+	CodeIdempotency     int64 = 7
+	CodeUnauthorized    int64 = 8
+	CodeVersionMismatch int64 = 9
+	CodeIfMatchRequired int64 = 10
+	CodeDeleted         int64 = 11
+	CodeEmailToken      int64 = 12
+	CodeCSRF            int64 = 13
+	CodeOIDCLogin       int64 = 14
+	CodeOIDCUnavailable int64 = 15
+	CodeInvalidMFACode  int64 = 16
+	CodeMFALocked       int64 = 17
+	CodeRateLimited     int64 = 18
+)
+
+var errCSRF = errors.New("csrf token is missing or does not match")
+
+const internalErrorMessage = "internal server error"
+
+func errorCode(err error) int64 {
+	switch {
+	case errors.Is(err, auth.ErrInvalidCredentials), errors.Is(err, auth.ErrInvalidToken):
+		return CodeUnauthorized
+	case errors.Is(err, auth.ErrLoginTaken), errors.Is(err, auth.ErrEmailTaken), errors.Is(err, auth.ErrIdentityConflict):
+		return CodeConflict
+	case errors.Is(err, auth.ErrInvalidEmailToken):
+		return CodeEmailToken
+	case errors.Is(err, auth.ErrValidation):
+		return CodeValidation
+	case errors.Is(err, auth.ErrSessionNotFound), errors.Is(err, auth.ErrUnknownProvider):
+		return CodeNotFound
+	case errors.Is(err, auth.ErrUserNotFound), errors.Is(err, auth.ErrMFANotEnrolled):
+		return CodeNotFound
+	case errors.Is(err, auth.ErrMFAAlreadyEnabled):
+		return CodeConflict
+	case errors.Is(err, auth.ErrAPIKeyNotFound):
+		return CodeNotFound
+	case errors.Is(err, auth.ErrInvalidAPIKey):
+		return CodeValidation
+	case errors.Is(err, auth.ErrInvalidMFACode):
+		return CodeInvalidMFACode
+	case errors.Is(err, auth.ErrMFALocked):
+		return CodeMFALocked
+	case errors.Is(err, auth.ErrOIDCLogin):
+		return CodeOIDCLogin
+	case errors.Is(err, auth.ErrOIDCUnavailable):
+		return CodeOIDCUnavailable
+	case errors.Is(err, errCSRF):
+		return CodeCSRF
+	case errors.Is(err, errRateLimited):
+		return CodeRateLimited
+	case errors.Is(err, user.ErrNotFound):
+		return CodeNotFound
+	case errors.Is(err, user.ErrAlreadyDeleted):
+		return CodeAlreadyDeleted
+	case errors.Is(err, user.ErrDeleted):
+		return CodeDeleted
+	case errors.Is(err, user.ErrNotDeleted):
+		return CodeConflict
+	case errors.Is(err, user.ErrConflict), errors.Is(err, user.ErrEmailTaken), errors.Is(err, user.ErrUsernameTaken):
+		return CodeConflict
+	case errors.Is(err, user.ErrValidation):
+		return CodeValidation
+	case errors.Is(err, user.ErrIdempotencyMismatch):
+		return CodeIdempotency
+	case errors.Is(err, user.ErrInvalidCursor):
+		return CodeBadRequest
+	case errors.Is(err, user.ErrVersionMismatch):
+		return CodeVersionMismatch
+	case errors.Is(err, errIfMatchRequired):
+		return CodeIfMatchRequired
+	case errors.Is(err, webhook.ErrNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return CodeNotFound
+	case errors.Is(err, webhook.ErrNotDead):
+		return CodeConflict
+	case errors.Is(err, webhook.ErrValidation):
+		return CodeValidation
+	case errors.Is(err, webhook.ErrInvalidCursor):
+		return CodeBadRequest
+	default:
+		return CodeInternal
+	}
+}
+
+// apiError формирует тело ответа. Текст внутренних ошибок и ошибок обращения к OIDC провайдеру
+// не отдается клиенту, а пишется в лог.
+func apiError(ctx context.Context, err error) *models.Error {
+	code := errorCode(err)
+	msg := err.Error()
+
+	switch code {
+	case CodeInternal:
+		zerolog.Ctx(ctx).Err(err).Msg("request failed")
+
+		msg = internalErrorMessage
+	case CodeOIDCUnavailable:
+		zerolog.Ctx(ctx).Err(err).Msg("oidc provider request failed")
+
+		msg = auth.ErrOIDCUnavailable.Error()
+	}
+
+	return &models.Error{Code: code, Message: &msg}
+}
+
+func badRequest(err error) *models.Error {
+	msg := err.Error()
+
+	return &models.Error{Code: CodeBadRequest, Message: &msg}
+}
@@ -0,0 +1,41 @@
+package restapi
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"otusgruz/internal/service/api/user"
+)
+
+var errIfMatchRequired = errors.New("If-Match header is required")
+
+// etag формирует сильный ETag из версии пользователя.
+func etag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ifMatchVersion разбирает If-Match в версию пользователя. nil означает "*", то есть любую версию.
+// Слабые и чужие ETag не совпадают ни с одной версией.
+func ifMatchVersion(header *string) (*int64, error) {
+	if header == nil || strings.TrimSpace(*header) == "" {
+		return nil, errIfMatchRequired
+	}
+
+	value := strings.TrimSpace(*header)
+	if value == "*" {
+		return nil, nil //nolint:nilnil
+	}
+
+	raw, err := strconv.Unquote(value)
+	if err != nil || !strings.HasPrefix(value, `"`) {
+		return nil, user.ErrVersionMismatch
+	}
+
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, user.ErrVersionMismatch
+	}
+
+	return &version, nil
+}
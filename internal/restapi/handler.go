@@ -4,19 +4,40 @@ import (
 	"otusgruz/internal/models"
 	"otusgruz/internal/restapi/operations/other"
 	"otusgruz/internal/restapi/operations/user_c_r_u_d"
+	"otusgruz/internal/service/api/auth"
+	"otusgruz/internal/service/api/health"
 	"otusgruz/internal/service/api/user"
+	"otusgruz/internal/service/api/webhook"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/google/uuid"
 )
 
 type Handler struct {
-	userSrv user.Service
+	userSrv    user.Service
+	healthSrv  health.Service
+	authSrv    auth.Service
+	webhookSrv webhook.Service
+	// trusted и cookies те же настройки, что переданы в ConfigureAuth
+	trusted TrustedProxy
+	cookies CookieSession
 }
 
-func NewHandler(userSrv user.Service) *Handler {
+func NewHandler(
+	userSrv user.Service,
+	healthSrv health.Service,
+	authSrv auth.Service,
+	webhookSrv webhook.Service,
+	trusted TrustedProxy,
+	cookies CookieSession,
+) *Handler {
 	return &Handler{
-		userSrv: userSrv,
+		userSrv:    userSrv,
+		healthSrv:  healthSrv,
+		authSrv:    authSrv,
+		webhookSrv: webhookSrv,
+		trusted:    trusted,
+		cookies:    cookies,
 	}
 }
 
@@ -24,72 +45,255 @@ func (h *Handler) GetHealth(_ other.GetHealthParams) middleware.Responder {
 	return other.NewGetHealthOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "OK"})
 }
 
-func (h *Handler) GetUser(params user_c_r_u_d.GetUserGUIDParams) middleware.Responder {
-	var errText string
+func (h *Handler) GetHealthLive(_ other.GetHealthLiveParams) middleware.Responder {
+	return other.NewGetHealthLiveOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "OK"})
+}
+
+func (h *Handler) GetHealthReady(params other.GetHealthReadyParams) middleware.Responder {
+	report, ready := h.healthSrv.Ready(params.HTTPRequest.Context())
+	if !ready {
+		return other.NewGetHealthReadyServiceUnavailable().WithPayload(report)
+	}
+
+	return other.NewGetHealthReadyOK().WithPayload(report)
+}
+
+func (h *Handler) GetUser(params user_c_r_u_d.GetUserGUIDParams, principal interface{}) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	userGUID, err := uuid.Parse(params.GUID.String())
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewGetUserGUIDBadRequest().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		return user_c_r_u_d.NewGetUserGUIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	includeDeleted := *params.IncludeDeleted && principalOf(principal).HasRole(auth.RoleAdmin)
+
+	res, err := h.userSrv.GetUser(ctx, userGUID, includeDeleted)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return user_c_r_u_d.NewGetUserGUIDNotFound().WithPayload(apiError(ctx, err))
+		case CodeDeleted:
+			return user_c_r_u_d.NewGetUserGUIDGone().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewGetUserGUIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return user_c_r_u_d.NewGetUserGUIDOK().WithETag(etag(res.Version)).WithPayload(res)
+}
+
+func (h *Handler) ListUsers(params user_c_r_u_d.GetUserParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	filter := user.ListFilter{
+		Limit:          *params.Limit,
+		Name:           params.Name,
+		Occupation:     params.Occupation,
+		IsDeleted:      params.IsDeleted,
+		IncludeDeleted: *params.IncludeDeleted && principalOf(principal).HasRole(auth.RoleAdmin),
+		Ascending:      *params.Sort == "created_at",
+	}
+
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
 	}
 
-	res, err := h.userSrv.GetUser(ctx, userGUID)
+	res, err := h.userSrv.ListUsers(ctx, filter)
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewGetUserGUIDInternalServerError().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		switch errorCode(err) {
+		case CodeBadRequest:
+			return user_c_r_u_d.NewGetUserBadRequest().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewGetUserInternalServerError().WithPayload(apiError(ctx, err))
+		}
 	}
 
-	return user_c_r_u_d.NewGetUserGUIDOK().WithPayload(res)
+	return user_c_r_u_d.NewGetUserOK().WithPayload(res)
 }
 
-func (h *Handler) CreateUser(params user_c_r_u_d.PostUserParams) middleware.Responder {
-	var errText string
+func (h *Handler) CreateUser(params user_c_r_u_d.PostUserParams, principal interface{}) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
-	res, err := h.userSrv.CreateUser(ctx, params.Request)
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+	}
+
+	res, err := h.userSrv.CreateUser(ctx, params.Request, idempotencyKey, actorOf(principal))
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewPostUserInternalServerError().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		switch errorCode(err) {
+		case CodeConflict:
+			return user_c_r_u_d.NewPostUserConflict().WithPayload(apiError(ctx, err))
+		case CodeValidation, CodeIdempotency:
+			return user_c_r_u_d.NewPostUserUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewPostUserInternalServerError().WithPayload(apiError(ctx, err))
+		}
 	}
 
-	return user_c_r_u_d.NewPostUserOK().WithPayload(res)
+	location := (&user_c_r_u_d.GetUserGUIDURL{GUID: res.GUID}).String() //nolint:exhaustruct
+
+	return user_c_r_u_d.NewPostUserCreated().WithLocation(location).WithPayload(res)
 }
 
-func (h *Handler) UpdateUser(params user_c_r_u_d.PatchUserGUIDParams) middleware.Responder {
-	var errText string
+func (h *Handler) PatchUser(params user_c_r_u_d.PatchUserGUIDParams, principal interface{}) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	userGUID, err := uuid.Parse(params.GUID.String())
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewPatchUserGUIDBadRequest().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		return user_c_r_u_d.NewPatchUserGUIDBadRequest().WithPayload(badRequest(err))
 	}
 
-	res, err := h.userSrv.UpdateUser(ctx, userGUID, params.Request)
+	version, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewPatchUserGUIDInternalServerError().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		if errorCode(err) == CodeIfMatchRequired {
+			return user_c_r_u_d.NewPatchUserGUIDPreconditionRequired().WithPayload(apiError(ctx, err))
+		}
+
+		return user_c_r_u_d.NewPatchUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+	}
+
+	res, err := h.userSrv.PatchUser(ctx, userGUID, version, params.Request, patchNulls(params.HTTPRequest), actorOf(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeVersionMismatch:
+			return user_c_r_u_d.NewPatchUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return user_c_r_u_d.NewPatchUserGUIDNotFound().WithPayload(apiError(ctx, err))
+		case CodeDeleted:
+			return user_c_r_u_d.NewPatchUserGUIDGone().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return user_c_r_u_d.NewPatchUserGUIDConflict().WithPayload(apiError(ctx, err))
+		case CodeValidation:
+			return user_c_r_u_d.NewPatchUserGUIDUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewPatchUserGUIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
 	}
 
 	return user_c_r_u_d.NewPatchUserGUIDOK().WithPayload(res)
 }
 
-func (h *Handler) DeleteUser(params user_c_r_u_d.DeleteUserGUIDParams) middleware.Responder {
-	var errText string
+func (h *Handler) UpdateUser(params user_c_r_u_d.PutUserGUIDParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return user_c_r_u_d.NewPutUserGUIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		if errorCode(err) == CodeIfMatchRequired {
+			return user_c_r_u_d.NewPutUserGUIDPreconditionRequired().WithPayload(apiError(ctx, err))
+		}
+
+		return user_c_r_u_d.NewPutUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+	}
+
+	res, err := h.userSrv.UpdateUser(ctx, userGUID, version, params.Request, actorOf(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeVersionMismatch:
+			return user_c_r_u_d.NewPutUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return user_c_r_u_d.NewPutUserGUIDNotFound().WithPayload(apiError(ctx, err))
+		case CodeDeleted:
+			return user_c_r_u_d.NewPutUserGUIDGone().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return user_c_r_u_d.NewPutUserGUIDConflict().WithPayload(apiError(ctx, err))
+		case CodeValidation:
+			return user_c_r_u_d.NewPutUserGUIDUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewPutUserGUIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return user_c_r_u_d.NewPutUserGUIDOK().WithPayload(res)
+}
+
+func (h *Handler) DeleteUser(params user_c_r_u_d.DeleteUserGUIDParams, principal interface{}) middleware.Responder {
 	ctx := params.HTTPRequest.Context()
 
 	userGUID, err := uuid.Parse(params.GUID.String())
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewDeleteUserGUIDBadRequest().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		return user_c_r_u_d.NewDeleteUserGUIDBadRequest().WithPayload(badRequest(err))
 	}
 
-	res, err := h.userSrv.DeleteUser(ctx, userGUID)
+	version, err := ifMatchVersion(params.IfMatch)
 	if err != nil {
-		errText = err.Error()
-		return user_c_r_u_d.NewDeleteUserGUIDInternalServerError().WithPayload(&models.Error{Code: 0o3, Message: &errText})
+		if errorCode(err) == CodeIfMatchRequired {
+			return user_c_r_u_d.NewDeleteUserGUIDPreconditionRequired().WithPayload(apiError(ctx, err))
+		}
+
+		return user_c_r_u_d.NewDeleteUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+	}
+
+	res, err := h.userSrv.DeleteUser(ctx, userGUID, version, actorOf(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeVersionMismatch:
+			return user_c_r_u_d.NewDeleteUserGUIDPreconditionFailed().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return user_c_r_u_d.NewDeleteUserGUIDNotFound().WithPayload(apiError(ctx, err))
+		case CodeAlreadyDeleted:
+			return user_c_r_u_d.NewDeleteUserGUIDConflict().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewDeleteUserGUIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
 	}
 
 	return user_c_r_u_d.NewDeleteUserGUIDOK().WithPayload(res)
 }
+
+func (h *Handler) RestoreUser(params user_c_r_u_d.PostUserGUIDRestoreParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return user_c_r_u_d.NewPostUserGUIDRestoreBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.userSrv.RestoreUser(ctx, userGUID, actorOf(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return user_c_r_u_d.NewPostUserGUIDRestoreNotFound().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return user_c_r_u_d.NewPostUserGUIDRestoreConflict().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewPostUserGUIDRestoreInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return user_c_r_u_d.NewPostUserGUIDRestoreOK().WithETag(etag(res.Version)).WithPayload(res)
+}
+
+func (h *Handler) UserHistory(params user_c_r_u_d.GetUserGUIDHistoryParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return user_c_r_u_d.NewGetUserGUIDHistoryBadRequest().WithPayload(badRequest(err))
+	}
+
+	var cursor string
+	if params.Cursor != nil {
+		cursor = *params.Cursor
+	}
+
+	res, err := h.userSrv.History(ctx, userGUID, cursor, *params.Limit)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return user_c_r_u_d.NewGetUserGUIDHistoryNotFound().WithPayload(apiError(ctx, err))
+		case CodeBadRequest:
+			return user_c_r_u_d.NewGetUserGUIDHistoryBadRequest().WithPayload(apiError(ctx, err))
+		default:
+			return user_c_r_u_d.NewGetUserGUIDHistoryInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return user_c_r_u_d.NewGetUserGUIDHistoryOK().WithPayload(res)
+}
@@ -0,0 +1,203 @@
+package restapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/restapi/operations/auth"
+	"otusgruz/internal/restapi/operations/two_factor"
+	authsrv "otusgruz/internal/service/api/auth"
+)
+
+func (h *Handler) VerifyMFA(params auth.PostAuthMfaVerifyParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.authSrv.VerifyMFA(ctx, params.Request.MfaToken, params.Request.Code, h.client(params.HTTPRequest))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeUnauthorized, CodeInvalidMFACode:
+			return auth.NewPostAuthMfaVerifyUnauthorized().WithPayload(apiError(ctx, err))
+		case CodeMFALocked:
+			return auth.NewPostAuthMfaVerifyTooManyRequests().WithRetryAfter(retryAfter(err)).WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewPostAuthMfaVerifyInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	cookies, err := h.cookies.issue(res)
+	if err != nil {
+		return auth.NewPostAuthMfaVerifyInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(auth.NewPostAuthMfaVerifyOK().WithPayload(res), cookies)
+}
+
+func (h *Handler) MFAStatus(params two_factor.GetUserGUIDMfaParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewGetUserGUIDMfaBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.MFAStatus(ctx, userGUID)
+	if err != nil {
+		return two_factor.NewGetUserGUIDMfaInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return two_factor.NewGetUserGUIDMfaOK().WithPayload(res)
+}
+
+func (h *Handler) EnrollTOTP(params two_factor.PostUserGUIDMfaTotpParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewPostUserGUIDMfaTotpBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.EnrollTOTP(ctx, userGUID)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return two_factor.NewPostUserGUIDMfaTotpNotFound().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return two_factor.NewPostUserGUIDMfaTotpConflict().WithPayload(apiError(ctx, err))
+		default:
+			return two_factor.NewPostUserGUIDMfaTotpInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return two_factor.NewPostUserGUIDMfaTotpOK().WithPayload(res)
+}
+
+func (h *Handler) TOTPQRCode(params two_factor.GetUserGUIDMfaTotpQrParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewGetUserGUIDMfaTotpQrBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.TOTPQRCode(ctx, userGUID)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return two_factor.NewGetUserGUIDMfaTotpQrNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return two_factor.NewGetUserGUIDMfaTotpQrInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return two_factor.NewGetUserGUIDMfaTotpQrOK().WithPayload(io.NopCloser(bytes.NewReader(res)))
+}
+
+func (h *Handler) ConfirmTOTP(params two_factor.PostUserGUIDMfaTotpConfirmParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewPostUserGUIDMfaTotpConfirmBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.ConfirmTOTP(ctx, userGUID, params.Request.Code)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeInvalidMFACode:
+			return two_factor.NewPostUserGUIDMfaTotpConfirmBadRequest().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return two_factor.NewPostUserGUIDMfaTotpConfirmNotFound().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return two_factor.NewPostUserGUIDMfaTotpConfirmConflict().WithPayload(apiError(ctx, err))
+		case CodeMFALocked:
+			return two_factor.NewPostUserGUIDMfaTotpConfirmTooManyRequests().WithRetryAfter(retryAfter(err)).WithPayload(apiError(ctx, err))
+		default:
+			return two_factor.NewPostUserGUIDMfaTotpConfirmInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return two_factor.NewPostUserGUIDMfaTotpConfirmOK().WithPayload(res)
+}
+
+func (h *Handler) RegenerateRecoveryCodes(params two_factor.PostUserGUIDMfaRecoveryCodesParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewPostUserGUIDMfaRecoveryCodesBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.RegenerateRecoveryCodes(ctx, userGUID, params.Request.Code)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeInvalidMFACode:
+			return two_factor.NewPostUserGUIDMfaRecoveryCodesBadRequest().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return two_factor.NewPostUserGUIDMfaRecoveryCodesNotFound().WithPayload(apiError(ctx, err))
+		case CodeMFALocked:
+			return two_factor.NewPostUserGUIDMfaRecoveryCodesTooManyRequests().WithRetryAfter(retryAfter(err)).WithPayload(apiError(ctx, err))
+		default:
+			return two_factor.NewPostUserGUIDMfaRecoveryCodesInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return two_factor.NewPostUserGUIDMfaRecoveryCodesOK().WithPayload(res)
+}
+
+// DisableTOTP администратор отключает второй фактор другого пользователя без кода, например при потере телефона.
+func (h *Handler) DisableTOTP(params two_factor.DeleteUserGUIDMfaTotpParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return two_factor.NewDeleteUserGUIDMfaTotpBadRequest().WithPayload(badRequest(err))
+	}
+
+	p := principalOf(principal)
+	requireCode := p.Subject == userGUID || !p.HasRole(authsrv.RoleAdmin)
+
+	var code string
+	if params.Request != nil {
+		code = params.Request.Code
+	}
+
+	// пустой код не считается неверной попыткой
+	if requireCode && code == "" {
+		err = fmt.Errorf("%w: code is required", authsrv.ErrInvalidMFACode)
+
+		return two_factor.NewDeleteUserGUIDMfaTotpBadRequest().WithPayload(apiError(ctx, err))
+	}
+
+	if err = h.authSrv.DisableTOTP(ctx, userGUID, code, requireCode); err != nil {
+		switch errorCode(err) {
+		case CodeInvalidMFACode:
+			return two_factor.NewDeleteUserGUIDMfaTotpBadRequest().WithPayload(apiError(ctx, err))
+		case CodeNotFound:
+			return two_factor.NewDeleteUserGUIDMfaTotpNotFound().WithPayload(apiError(ctx, err))
+		case CodeMFALocked:
+			return two_factor.NewDeleteUserGUIDMfaTotpTooManyRequests().WithRetryAfter(retryAfter(err)).WithPayload(apiError(ctx, err))
+		default:
+			return two_factor.NewDeleteUserGUIDMfaTotpInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return two_factor.NewDeleteUserGUIDMfaTotpOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Two-factor authentication disabled"})
+}
+
+// retryAfter секунды до конца блокировки проверки кодов для заголовка Retry-After.
+func retryAfter(err error) int64 {
+	var locked *authsrv.MFALockedError
+	if !errors.As(err, &locked) {
+		return 0
+	}
+
+	return max(1, int64(math.Ceil(time.Until(locked.Until).Seconds())))
+}
@@ -0,0 +1,76 @@
+package restapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	"otusgruz/internal/restapi/operations/auth"
+	authsrv "otusgruz/internal/service/api/auth"
+)
+
+func (h *Handler) OIDCStart(params auth.GetAuthOidcProviderStartParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.authSrv.OIDCStart(ctx, params.Provider, swag.StringValue(params.LoginHint))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return auth.NewGetAuthOidcProviderStartNotFound().WithPayload(apiError(ctx, err))
+		case CodeOIDCUnavailable:
+			return auth.NewGetAuthOidcProviderStartBadGateway().WithPayload(apiError(ctx, err))
+		default:
+			return auth.NewGetAuthOidcProviderStartInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	flow := h.cookies.oidcFlow(res.Flow, time.Until(res.ExpiresAt))
+
+	return withCookies(auth.NewGetAuthOidcProviderStartFound().WithLocation(res.URL), []*http.Cookie{flow})
+}
+
+func (h *Handler) OIDCCallback(params auth.GetAuthOidcProviderCallbackParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	var flow string
+	if cookie, err := params.HTTPRequest.Cookie(CookieOIDCFlow); err == nil {
+		flow = cookie.Value
+	}
+
+	// попытка входа одноразовая, cookie удаляется при любом исходе
+	cookies := []*http.Cookie{h.cookies.oidcFlow("", -1)}
+
+	res, challenge, err := h.authSrv.OIDCCallback(ctx, params.Provider, authsrv.OIDCCallbackParams{
+		Flow:  flow,
+		Code:  swag.StringValue(params.Code),
+		State: swag.StringValue(params.State),
+		Error: swag.StringValue(params.Error),
+	}, h.client(params.HTTPRequest))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeUnauthorized, CodeOIDCLogin:
+			return withCookies(auth.NewGetAuthOidcProviderCallbackUnauthorized().WithPayload(apiError(ctx, err)), cookies)
+		case CodeNotFound:
+			return withCookies(auth.NewGetAuthOidcProviderCallbackNotFound().WithPayload(apiError(ctx, err)), cookies)
+		case CodeConflict:
+			return withCookies(auth.NewGetAuthOidcProviderCallbackConflict().WithPayload(apiError(ctx, err)), cookies)
+		case CodeOIDCUnavailable:
+			return withCookies(auth.NewGetAuthOidcProviderCallbackBadGateway().WithPayload(apiError(ctx, err)), cookies)
+		default:
+			return withCookies(auth.NewGetAuthOidcProviderCallbackInternalServerError().WithPayload(apiError(ctx, err)), cookies)
+		}
+	}
+
+	if challenge != nil {
+		return withCookies(auth.NewGetAuthOidcProviderCallbackAccepted().WithPayload(challenge), cookies)
+	}
+
+	session, err := h.cookies.issue(res)
+	if err != nil {
+		return auth.NewGetAuthOidcProviderCallbackInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return withCookies(auth.NewGetAuthOidcProviderCallbackOK().WithPayload(res), append(cookies, session...))
+}
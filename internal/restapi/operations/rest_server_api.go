@@ -19,8 +19,13 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"otusgruz/internal/restapi/operations/api_keys"
+	"otusgruz/internal/restapi/operations/auth"
 	"otusgruz/internal/restapi/operations/other"
+	"otusgruz/internal/restapi/operations/sessions"
+	"otusgruz/internal/restapi/operations/two_factor"
 	"otusgruz/internal/restapi/operations/user_c_r_u_d"
+	"otusgruz/internal/restapi/operations/webhooks"
 )
 
 // NewRestServerAPI creates a new RestServer instance
@@ -43,27 +48,159 @@ func NewRestServerAPI(spec *loads.Document) *RestServerAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
-		UsercrudDeleteUserGUIDHandler: user_c_r_u_d.DeleteUserGUIDHandlerFunc(func(params user_c_r_u_d.DeleteUserGUIDParams) middleware.Responder {
+		ApikeysDeleteAPIKeysIDHandler: api_keys.DeleteAPIKeysIDHandlerFunc(func(params api_keys.DeleteAPIKeysIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation api_keys.DeleteAPIKeysID has not yet been implemented")
+		}),
+		UsercrudDeleteUserGUIDHandler: user_c_r_u_d.DeleteUserGUIDHandlerFunc(func(params user_c_r_u_d.DeleteUserGUIDParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation user_c_r_u_d.DeleteUserGUID has not yet been implemented")
 		}),
+		TwofactorDeleteUserGUIDMfaTotpHandler: two_factor.DeleteUserGUIDMfaTotpHandlerFunc(func(params two_factor.DeleteUserGUIDMfaTotpParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.DeleteUserGUIDMfaTotp has not yet been implemented")
+		}),
+		SessionsDeleteUserGUIDSessionsIDHandler: sessions.DeleteUserGUIDSessionsIDHandlerFunc(func(params sessions.DeleteUserGUIDSessionsIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation sessions.DeleteUserGUIDSessionsID has not yet been implemented")
+		}),
+		WebhooksDeleteWebhooksIDHandler: webhooks.DeleteWebhooksIDHandlerFunc(func(params webhooks.DeleteWebhooksIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.DeleteWebhooksID has not yet been implemented")
+		}),
+		ApikeysGetAPIKeysHandler: api_keys.GetAPIKeysHandlerFunc(func(params api_keys.GetAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation api_keys.GetAPIKeys has not yet been implemented")
+		}),
+		ApikeysGetAPIKeysIDHandler: api_keys.GetAPIKeysIDHandlerFunc(func(params api_keys.GetAPIKeysIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation api_keys.GetAPIKeysID has not yet been implemented")
+		}),
+		AuthGetAuthOidcProviderCallbackHandler: auth.GetAuthOidcProviderCallbackHandlerFunc(func(params auth.GetAuthOidcProviderCallbackParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.GetAuthOidcProviderCallback has not yet been implemented")
+		}),
+		AuthGetAuthOidcProviderStartHandler: auth.GetAuthOidcProviderStartHandlerFunc(func(params auth.GetAuthOidcProviderStartParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.GetAuthOidcProviderStart has not yet been implemented")
+		}),
 		OtherGetHealthHandler: other.GetHealthHandlerFunc(func(params other.GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation other.GetHealth has not yet been implemented")
 		}),
-		UsercrudGetUserGUIDHandler: user_c_r_u_d.GetUserGUIDHandlerFunc(func(params user_c_r_u_d.GetUserGUIDParams) middleware.Responder {
+		OtherGetHealthLiveHandler: other.GetHealthLiveHandlerFunc(func(params other.GetHealthLiveParams) middleware.Responder {
+			return middleware.NotImplemented("operation other.GetHealthLive has not yet been implemented")
+		}),
+		OtherGetHealthReadyHandler: other.GetHealthReadyHandlerFunc(func(params other.GetHealthReadyParams) middleware.Responder {
+			return middleware.NotImplemented("operation other.GetHealthReady has not yet been implemented")
+		}),
+		UsercrudGetUserHandler: user_c_r_u_d.GetUserHandlerFunc(func(params user_c_r_u_d.GetUserParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation user_c_r_u_d.GetUser has not yet been implemented")
+		}),
+		UsercrudGetUserGUIDHandler: user_c_r_u_d.GetUserGUIDHandlerFunc(func(params user_c_r_u_d.GetUserGUIDParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation user_c_r_u_d.GetUserGUID has not yet been implemented")
 		}),
-		UsercrudPatchUserGUIDHandler: user_c_r_u_d.PatchUserGUIDHandlerFunc(func(params user_c_r_u_d.PatchUserGUIDParams) middleware.Responder {
+		UsercrudGetUserGUIDHistoryHandler: user_c_r_u_d.GetUserGUIDHistoryHandlerFunc(func(params user_c_r_u_d.GetUserGUIDHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation user_c_r_u_d.GetUserGUIDHistory has not yet been implemented")
+		}),
+		TwofactorGetUserGUIDMfaHandler: two_factor.GetUserGUIDMfaHandlerFunc(func(params two_factor.GetUserGUIDMfaParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.GetUserGUIDMfa has not yet been implemented")
+		}),
+		TwofactorGetUserGUIDMfaTotpQrHandler: two_factor.GetUserGUIDMfaTotpQrHandlerFunc(func(params two_factor.GetUserGUIDMfaTotpQrParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.GetUserGUIDMfaTotpQr has not yet been implemented")
+		}),
+		SessionsGetUserGUIDSessionsHandler: sessions.GetUserGUIDSessionsHandlerFunc(func(params sessions.GetUserGUIDSessionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation sessions.GetUserGUIDSessions has not yet been implemented")
+		}),
+		WebhooksGetWebhooksHandler: webhooks.GetWebhooksHandlerFunc(func(params webhooks.GetWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.GetWebhooks has not yet been implemented")
+		}),
+		WebhooksGetWebhooksIDHandler: webhooks.GetWebhooksIDHandlerFunc(func(params webhooks.GetWebhooksIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.GetWebhooksID has not yet been implemented")
+		}),
+		WebhooksGetWebhooksIDDeliveriesHandler: webhooks.GetWebhooksIDDeliveriesHandlerFunc(func(params webhooks.GetWebhooksIDDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.GetWebhooksIDDeliveries has not yet been implemented")
+		}),
+		UsercrudPatchUserGUIDHandler: user_c_r_u_d.PatchUserGUIDHandlerFunc(func(params user_c_r_u_d.PatchUserGUIDParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation user_c_r_u_d.PatchUserGUID has not yet been implemented")
 		}),
-		UsercrudPostUserHandler: user_c_r_u_d.PostUserHandlerFunc(func(params user_c_r_u_d.PostUserParams) middleware.Responder {
+		ApikeysPostAPIKeysHandler: api_keys.PostAPIKeysHandlerFunc(func(params api_keys.PostAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation api_keys.PostAPIKeys has not yet been implemented")
+		}),
+		AuthPostAuthLoginHandler: auth.PostAuthLoginHandlerFunc(func(params auth.PostAuthLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthLogin has not yet been implemented")
+		}),
+		AuthPostAuthLogoutHandler: auth.PostAuthLogoutHandlerFunc(func(params auth.PostAuthLogoutParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthLogout has not yet been implemented")
+		}),
+		AuthPostAuthMfaVerifyHandler: auth.PostAuthMfaVerifyHandlerFunc(func(params auth.PostAuthMfaVerifyParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthMfaVerify has not yet been implemented")
+		}),
+		AuthPostAuthPasswordForgotHandler: auth.PostAuthPasswordForgotHandlerFunc(func(params auth.PostAuthPasswordForgotParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthPasswordForgot has not yet been implemented")
+		}),
+		AuthPostAuthPasswordResetHandler: auth.PostAuthPasswordResetHandlerFunc(func(params auth.PostAuthPasswordResetParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthPasswordReset has not yet been implemented")
+		}),
+		AuthPostAuthRefreshHandler: auth.PostAuthRefreshHandlerFunc(func(params auth.PostAuthRefreshParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthRefresh has not yet been implemented")
+		}),
+		AuthPostAuthRegisterHandler: auth.PostAuthRegisterHandlerFunc(func(params auth.PostAuthRegisterParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthRegister has not yet been implemented")
+		}),
+		AuthPostAuthVerifyEmailHandler: auth.PostAuthVerifyEmailHandlerFunc(func(params auth.PostAuthVerifyEmailParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthVerifyEmail has not yet been implemented")
+		}),
+		AuthPostAuthVerifyEmailResendHandler: auth.PostAuthVerifyEmailResendHandlerFunc(func(params auth.PostAuthVerifyEmailResendParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.PostAuthVerifyEmailResend has not yet been implemented")
+		}),
+		UsercrudPostUserHandler: user_c_r_u_d.PostUserHandlerFunc(func(params user_c_r_u_d.PostUserParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation user_c_r_u_d.PostUser has not yet been implemented")
 		}),
+		TwofactorPostUserGUIDMfaRecoveryCodesHandler: two_factor.PostUserGUIDMfaRecoveryCodesHandlerFunc(func(params two_factor.PostUserGUIDMfaRecoveryCodesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.PostUserGUIDMfaRecoveryCodes has not yet been implemented")
+		}),
+		TwofactorPostUserGUIDMfaTotpHandler: two_factor.PostUserGUIDMfaTotpHandlerFunc(func(params two_factor.PostUserGUIDMfaTotpParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.PostUserGUIDMfaTotp has not yet been implemented")
+		}),
+		TwofactorPostUserGUIDMfaTotpConfirmHandler: two_factor.PostUserGUIDMfaTotpConfirmHandlerFunc(func(params two_factor.PostUserGUIDMfaTotpConfirmParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation two_factor.PostUserGUIDMfaTotpConfirm has not yet been implemented")
+		}),
+		UsercrudPostUserGUIDRestoreHandler: user_c_r_u_d.PostUserGUIDRestoreHandlerFunc(func(params user_c_r_u_d.PostUserGUIDRestoreParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation user_c_r_u_d.PostUserGUIDRestore has not yet been implemented")
+		}),
+		WebhooksPostWebhooksHandler: webhooks.PostWebhooksHandlerFunc(func(params webhooks.PostWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.PostWebhooks has not yet been implemented")
+		}),
+		WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler: webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverHandlerFunc(func(params webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliver has not yet been implemented")
+		}),
+		UsercrudPutUserGUIDHandler: user_c_r_u_d.PutUserGUIDHandlerFunc(func(params user_c_r_u_d.PutUserGUIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation user_c_r_u_d.PutUserGUID has not yet been implemented")
+		}),
+		WebhooksPutWebhooksIDHandler: webhooks.PutWebhooksIDHandlerFunc(func(params webhooks.PutWebhooksIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.PutWebhooksID has not yet been implemented")
+		}),
+
+		// Applies when the "X-API-Key" header is set
+		APIKeyAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (APIKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		},
+
+		// Applies when the "Authorization" header is set
+		BearerAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		},
+
+		// Applies when the "Cookie" header is set
+		CookieSessionAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (CookieSession) Cookie from header param [Cookie] has not yet been implemented")
+		},
+
+		// Applies when the "X-User-Id" header is set
+		TrustedProxyAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (TrustedProxy) X-User-Id from header param [X-User-Id] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
-/*RestServerAPI API сервиса Otusgruz */
+/*RestServerAPI API сервиса Otusgruz. Частота запросов ограничена по алгоритму token bucket отдельно для каждого клиента: пользователя с действующим access token, ключа API или IP адреса. Запрос с ключом API расходует и лимит ключа, и лимит IP адреса. У части операций собственный лимит (RATE_LIMIT_OPERATIONS), остальные делят общий (RATE_LIMIT_DEFAULT), проверки состояния /health не ограничиваются. Ответы содержат заголовки RateLimit-Limit, RateLimit-Remaining и RateLimit-Reset, при превышении лимита возвращается 429 с заголовком Retry-After.
+ */
 type RestServerAPI struct {
 	spec            *loads.Document
 	context         *middleware.Context
@@ -90,22 +227,119 @@ type RestServerAPI struct {
 
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
+	//   - application/json-patch+json
+	//   - application/merge-patch+json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - image/png
+	BinProducer runtime.Producer
+
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// APIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-API-Key provided in the header
+	APIKeyAuth func(string) (interface{}, error)
+
+	// BearerAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	BearerAuth func(string) (interface{}, error)
+
+	// CookieSessionAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Cookie provided in the header
+	CookieSessionAuth func(string) (interface{}, error)
+
+	// TrustedProxyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-User-Id provided in the header
+	TrustedProxyAuth func(string) (interface{}, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// ApikeysDeleteAPIKeysIDHandler sets the operation handler for the delete API keys ID operation
+	ApikeysDeleteAPIKeysIDHandler api_keys.DeleteAPIKeysIDHandler
 	// UsercrudDeleteUserGUIDHandler sets the operation handler for the delete user GUID operation
 	UsercrudDeleteUserGUIDHandler user_c_r_u_d.DeleteUserGUIDHandler
+	// TwofactorDeleteUserGUIDMfaTotpHandler sets the operation handler for the delete user GUID mfa totp operation
+	TwofactorDeleteUserGUIDMfaTotpHandler two_factor.DeleteUserGUIDMfaTotpHandler
+	// SessionsDeleteUserGUIDSessionsIDHandler sets the operation handler for the delete user GUID sessions ID operation
+	SessionsDeleteUserGUIDSessionsIDHandler sessions.DeleteUserGUIDSessionsIDHandler
+	// WebhooksDeleteWebhooksIDHandler sets the operation handler for the delete webhooks ID operation
+	WebhooksDeleteWebhooksIDHandler webhooks.DeleteWebhooksIDHandler
+	// ApikeysGetAPIKeysHandler sets the operation handler for the get API keys operation
+	ApikeysGetAPIKeysHandler api_keys.GetAPIKeysHandler
+	// ApikeysGetAPIKeysIDHandler sets the operation handler for the get API keys ID operation
+	ApikeysGetAPIKeysIDHandler api_keys.GetAPIKeysIDHandler
+	// AuthGetAuthOidcProviderCallbackHandler sets the operation handler for the get auth oidc provider callback operation
+	AuthGetAuthOidcProviderCallbackHandler auth.GetAuthOidcProviderCallbackHandler
+	// AuthGetAuthOidcProviderStartHandler sets the operation handler for the get auth oidc provider start operation
+	AuthGetAuthOidcProviderStartHandler auth.GetAuthOidcProviderStartHandler
 	// OtherGetHealthHandler sets the operation handler for the get health operation
 	OtherGetHealthHandler other.GetHealthHandler
+	// OtherGetHealthLiveHandler sets the operation handler for the get health live operation
+	OtherGetHealthLiveHandler other.GetHealthLiveHandler
+	// OtherGetHealthReadyHandler sets the operation handler for the get health ready operation
+	OtherGetHealthReadyHandler other.GetHealthReadyHandler
+	// UsercrudGetUserHandler sets the operation handler for the get user operation
+	UsercrudGetUserHandler user_c_r_u_d.GetUserHandler
 	// UsercrudGetUserGUIDHandler sets the operation handler for the get user GUID operation
 	UsercrudGetUserGUIDHandler user_c_r_u_d.GetUserGUIDHandler
+	// UsercrudGetUserGUIDHistoryHandler sets the operation handler for the get user GUID history operation
+	UsercrudGetUserGUIDHistoryHandler user_c_r_u_d.GetUserGUIDHistoryHandler
+	// TwofactorGetUserGUIDMfaHandler sets the operation handler for the get user GUID mfa operation
+	TwofactorGetUserGUIDMfaHandler two_factor.GetUserGUIDMfaHandler
+	// TwofactorGetUserGUIDMfaTotpQrHandler sets the operation handler for the get user GUID mfa totp qr operation
+	TwofactorGetUserGUIDMfaTotpQrHandler two_factor.GetUserGUIDMfaTotpQrHandler
+	// SessionsGetUserGUIDSessionsHandler sets the operation handler for the get user GUID sessions operation
+	SessionsGetUserGUIDSessionsHandler sessions.GetUserGUIDSessionsHandler
+	// WebhooksGetWebhooksHandler sets the operation handler for the get webhooks operation
+	WebhooksGetWebhooksHandler webhooks.GetWebhooksHandler
+	// WebhooksGetWebhooksIDHandler sets the operation handler for the get webhooks ID operation
+	WebhooksGetWebhooksIDHandler webhooks.GetWebhooksIDHandler
+	// WebhooksGetWebhooksIDDeliveriesHandler sets the operation handler for the get webhooks ID deliveries operation
+	WebhooksGetWebhooksIDDeliveriesHandler webhooks.GetWebhooksIDDeliveriesHandler
 	// UsercrudPatchUserGUIDHandler sets the operation handler for the patch user GUID operation
 	UsercrudPatchUserGUIDHandler user_c_r_u_d.PatchUserGUIDHandler
+	// ApikeysPostAPIKeysHandler sets the operation handler for the post API keys operation
+	ApikeysPostAPIKeysHandler api_keys.PostAPIKeysHandler
+	// AuthPostAuthLoginHandler sets the operation handler for the post auth login operation
+	AuthPostAuthLoginHandler auth.PostAuthLoginHandler
+	// AuthPostAuthLogoutHandler sets the operation handler for the post auth logout operation
+	AuthPostAuthLogoutHandler auth.PostAuthLogoutHandler
+	// AuthPostAuthMfaVerifyHandler sets the operation handler for the post auth mfa verify operation
+	AuthPostAuthMfaVerifyHandler auth.PostAuthMfaVerifyHandler
+	// AuthPostAuthPasswordForgotHandler sets the operation handler for the post auth password forgot operation
+	AuthPostAuthPasswordForgotHandler auth.PostAuthPasswordForgotHandler
+	// AuthPostAuthPasswordResetHandler sets the operation handler for the post auth password reset operation
+	AuthPostAuthPasswordResetHandler auth.PostAuthPasswordResetHandler
+	// AuthPostAuthRefreshHandler sets the operation handler for the post auth refresh operation
+	AuthPostAuthRefreshHandler auth.PostAuthRefreshHandler
+	// AuthPostAuthRegisterHandler sets the operation handler for the post auth register operation
+	AuthPostAuthRegisterHandler auth.PostAuthRegisterHandler
+	// AuthPostAuthVerifyEmailHandler sets the operation handler for the post auth verify email operation
+	AuthPostAuthVerifyEmailHandler auth.PostAuthVerifyEmailHandler
+	// AuthPostAuthVerifyEmailResendHandler sets the operation handler for the post auth verify email resend operation
+	AuthPostAuthVerifyEmailResendHandler auth.PostAuthVerifyEmailResendHandler
 	// UsercrudPostUserHandler sets the operation handler for the post user operation
 	UsercrudPostUserHandler user_c_r_u_d.PostUserHandler
+	// TwofactorPostUserGUIDMfaRecoveryCodesHandler sets the operation handler for the post user GUID mfa recovery codes operation
+	TwofactorPostUserGUIDMfaRecoveryCodesHandler two_factor.PostUserGUIDMfaRecoveryCodesHandler
+	// TwofactorPostUserGUIDMfaTotpHandler sets the operation handler for the post user GUID mfa totp operation
+	TwofactorPostUserGUIDMfaTotpHandler two_factor.PostUserGUIDMfaTotpHandler
+	// TwofactorPostUserGUIDMfaTotpConfirmHandler sets the operation handler for the post user GUID mfa totp confirm operation
+	TwofactorPostUserGUIDMfaTotpConfirmHandler two_factor.PostUserGUIDMfaTotpConfirmHandler
+	// UsercrudPostUserGUIDRestoreHandler sets the operation handler for the post user GUID restore operation
+	UsercrudPostUserGUIDRestoreHandler user_c_r_u_d.PostUserGUIDRestoreHandler
+	// WebhooksPostWebhooksHandler sets the operation handler for the post webhooks operation
+	WebhooksPostWebhooksHandler webhooks.PostWebhooksHandler
+	// WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler sets the operation handler for the post webhooks ID deliveries delivery ID redeliver operation
+	WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverHandler
+	// UsercrudPutUserGUIDHandler sets the operation handler for the put user GUID operation
+	UsercrudPutUserGUIDHandler user_c_r_u_d.PutUserGUIDHandler
+	// WebhooksPutWebhooksIDHandler sets the operation handler for the put webhooks ID operation
+	WebhooksPutWebhooksIDHandler webhooks.PutWebhooksIDHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -179,25 +413,153 @@ func (o *RestServerAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.APIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
+
+	if o.BearerAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
+	if o.CookieSessionAuth == nil {
+		unregistered = append(unregistered, "CookieAuth")
+	}
+
+	if o.TrustedProxyAuth == nil {
+		unregistered = append(unregistered, "XUserIDAuth")
+	}
+
+	if o.ApikeysDeleteAPIKeysIDHandler == nil {
+		unregistered = append(unregistered, "api_keys.DeleteAPIKeysIDHandler")
+	}
 	if o.UsercrudDeleteUserGUIDHandler == nil {
 		unregistered = append(unregistered, "user_c_r_u_d.DeleteUserGUIDHandler")
 	}
+	if o.TwofactorDeleteUserGUIDMfaTotpHandler == nil {
+		unregistered = append(unregistered, "two_factor.DeleteUserGUIDMfaTotpHandler")
+	}
+	if o.SessionsDeleteUserGUIDSessionsIDHandler == nil {
+		unregistered = append(unregistered, "sessions.DeleteUserGUIDSessionsIDHandler")
+	}
+	if o.WebhooksDeleteWebhooksIDHandler == nil {
+		unregistered = append(unregistered, "webhooks.DeleteWebhooksIDHandler")
+	}
+	if o.ApikeysGetAPIKeysHandler == nil {
+		unregistered = append(unregistered, "api_keys.GetAPIKeysHandler")
+	}
+	if o.ApikeysGetAPIKeysIDHandler == nil {
+		unregistered = append(unregistered, "api_keys.GetAPIKeysIDHandler")
+	}
+	if o.AuthGetAuthOidcProviderCallbackHandler == nil {
+		unregistered = append(unregistered, "auth.GetAuthOidcProviderCallbackHandler")
+	}
+	if o.AuthGetAuthOidcProviderStartHandler == nil {
+		unregistered = append(unregistered, "auth.GetAuthOidcProviderStartHandler")
+	}
 	if o.OtherGetHealthHandler == nil {
 		unregistered = append(unregistered, "other.GetHealthHandler")
 	}
+	if o.OtherGetHealthLiveHandler == nil {
+		unregistered = append(unregistered, "other.GetHealthLiveHandler")
+	}
+	if o.OtherGetHealthReadyHandler == nil {
+		unregistered = append(unregistered, "other.GetHealthReadyHandler")
+	}
+	if o.UsercrudGetUserHandler == nil {
+		unregistered = append(unregistered, "user_c_r_u_d.GetUserHandler")
+	}
 	if o.UsercrudGetUserGUIDHandler == nil {
 		unregistered = append(unregistered, "user_c_r_u_d.GetUserGUIDHandler")
 	}
+	if o.UsercrudGetUserGUIDHistoryHandler == nil {
+		unregistered = append(unregistered, "user_c_r_u_d.GetUserGUIDHistoryHandler")
+	}
+	if o.TwofactorGetUserGUIDMfaHandler == nil {
+		unregistered = append(unregistered, "two_factor.GetUserGUIDMfaHandler")
+	}
+	if o.TwofactorGetUserGUIDMfaTotpQrHandler == nil {
+		unregistered = append(unregistered, "two_factor.GetUserGUIDMfaTotpQrHandler")
+	}
+	if o.SessionsGetUserGUIDSessionsHandler == nil {
+		unregistered = append(unregistered, "sessions.GetUserGUIDSessionsHandler")
+	}
+	if o.WebhooksGetWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.GetWebhooksHandler")
+	}
+	if o.WebhooksGetWebhooksIDHandler == nil {
+		unregistered = append(unregistered, "webhooks.GetWebhooksIDHandler")
+	}
+	if o.WebhooksGetWebhooksIDDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhooks.GetWebhooksIDDeliveriesHandler")
+	}
 	if o.UsercrudPatchUserGUIDHandler == nil {
 		unregistered = append(unregistered, "user_c_r_u_d.PatchUserGUIDHandler")
 	}
+	if o.ApikeysPostAPIKeysHandler == nil {
+		unregistered = append(unregistered, "api_keys.PostAPIKeysHandler")
+	}
+	if o.AuthPostAuthLoginHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthLoginHandler")
+	}
+	if o.AuthPostAuthLogoutHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthLogoutHandler")
+	}
+	if o.AuthPostAuthMfaVerifyHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthMfaVerifyHandler")
+	}
+	if o.AuthPostAuthPasswordForgotHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthPasswordForgotHandler")
+	}
+	if o.AuthPostAuthPasswordResetHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthPasswordResetHandler")
+	}
+	if o.AuthPostAuthRefreshHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthRefreshHandler")
+	}
+	if o.AuthPostAuthRegisterHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthRegisterHandler")
+	}
+	if o.AuthPostAuthVerifyEmailHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthVerifyEmailHandler")
+	}
+	if o.AuthPostAuthVerifyEmailResendHandler == nil {
+		unregistered = append(unregistered, "auth.PostAuthVerifyEmailResendHandler")
+	}
 	if o.UsercrudPostUserHandler == nil {
 		unregistered = append(unregistered, "user_c_r_u_d.PostUserHandler")
 	}
+	if o.TwofactorPostUserGUIDMfaRecoveryCodesHandler == nil {
+		unregistered = append(unregistered, "two_factor.PostUserGUIDMfaRecoveryCodesHandler")
+	}
+	if o.TwofactorPostUserGUIDMfaTotpHandler == nil {
+		unregistered = append(unregistered, "two_factor.PostUserGUIDMfaTotpHandler")
+	}
+	if o.TwofactorPostUserGUIDMfaTotpConfirmHandler == nil {
+		unregistered = append(unregistered, "two_factor.PostUserGUIDMfaTotpConfirmHandler")
+	}
+	if o.UsercrudPostUserGUIDRestoreHandler == nil {
+		unregistered = append(unregistered, "user_c_r_u_d.PostUserGUIDRestoreHandler")
+	}
+	if o.WebhooksPostWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.PostWebhooksHandler")
+	}
+	if o.WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler == nil {
+		unregistered = append(unregistered, "webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverHandler")
+	}
+	if o.UsercrudPutUserGUIDHandler == nil {
+		unregistered = append(unregistered, "user_c_r_u_d.PutUserGUIDHandler")
+	}
+	if o.WebhooksPutWebhooksIDHandler == nil {
+		unregistered = append(unregistered, "webhooks.PutWebhooksIDHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -213,12 +575,33 @@ func (o *RestServerAPI) ServeErrorFor(operationID string) func(http.ResponseWrit
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *RestServerAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "APIKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.APIKeyAuth)
+
+		case "Bearer":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.BearerAuth)
+
+		case "CookieSession":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.CookieSessionAuth)
+
+		case "TrustedProxy":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.TrustedProxyAuth)
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *RestServerAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -229,6 +612,10 @@ func (o *RestServerAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Con
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/json-patch+json":
+			result["application/json-patch+json"] = o.JSONConsumer
+		case "application/merge-patch+json":
+			result["application/merge-patch+json"] = o.JSONConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -244,6 +631,8 @@ func (o *RestServerAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pro
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "image/png":
+			result["image/png"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
 		}
@@ -286,10 +675,42 @@ func (o *RestServerAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/api-keys/{id}"] = api_keys.NewDeleteAPIKeysID(o.context, o.ApikeysDeleteAPIKeysIDHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/user/{guid}"] = user_c_r_u_d.NewDeleteUserGUID(o.context, o.UsercrudDeleteUserGUIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/user/{guid}/mfa/totp"] = two_factor.NewDeleteUserGUIDMfaTotp(o.context, o.TwofactorDeleteUserGUIDMfaTotpHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/user/{guid}/sessions/{id}"] = sessions.NewDeleteUserGUIDSessionsID(o.context, o.SessionsDeleteUserGUIDSessionsIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{id}"] = webhooks.NewDeleteWebhooksID(o.context, o.WebhooksDeleteWebhooksIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api-keys"] = api_keys.NewGetAPIKeys(o.context, o.ApikeysGetAPIKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api-keys/{id}"] = api_keys.NewGetAPIKeysID(o.context, o.ApikeysGetAPIKeysIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/auth/oidc/{provider}/callback"] = auth.NewGetAuthOidcProviderCallback(o.context, o.AuthGetAuthOidcProviderCallbackHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/auth/oidc/{provider}/start"] = auth.NewGetAuthOidcProviderStart(o.context, o.AuthGetAuthOidcProviderStartHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -297,7 +718,47 @@ func (o *RestServerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/health/live"] = other.NewGetHealthLive(o.context, o.OtherGetHealthLiveHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/health/ready"] = other.NewGetHealthReady(o.context, o.OtherGetHealthReadyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user"] = user_c_r_u_d.NewGetUser(o.context, o.UsercrudGetUserHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{guid}"] = user_c_r_u_d.NewGetUserGUID(o.context, o.UsercrudGetUserGUIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{guid}/history"] = user_c_r_u_d.NewGetUserGUIDHistory(o.context, o.UsercrudGetUserGUIDHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{guid}/mfa"] = two_factor.NewGetUserGUIDMfa(o.context, o.TwofactorGetUserGUIDMfaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{guid}/mfa/totp/qr"] = two_factor.NewGetUserGUIDMfaTotpQr(o.context, o.TwofactorGetUserGUIDMfaTotpQrHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{guid}/sessions"] = sessions.NewGetUserGUIDSessions(o.context, o.SessionsGetUserGUIDSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhooks.NewGetWebhooks(o.context, o.WebhooksGetWebhooksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{id}"] = webhooks.NewGetWebhooksID(o.context, o.WebhooksGetWebhooksIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{id}/deliveries"] = webhooks.NewGetWebhooksIDDeliveries(o.context, o.WebhooksGetWebhooksIDDeliveriesHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -305,7 +766,79 @@ func (o *RestServerAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api-keys"] = api_keys.NewPostAPIKeys(o.context, o.ApikeysPostAPIKeysHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/login"] = auth.NewPostAuthLogin(o.context, o.AuthPostAuthLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/logout"] = auth.NewPostAuthLogout(o.context, o.AuthPostAuthLogoutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/mfa/verify"] = auth.NewPostAuthMfaVerify(o.context, o.AuthPostAuthMfaVerifyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/password/forgot"] = auth.NewPostAuthPasswordForgot(o.context, o.AuthPostAuthPasswordForgotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/password/reset"] = auth.NewPostAuthPasswordReset(o.context, o.AuthPostAuthPasswordResetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/refresh"] = auth.NewPostAuthRefresh(o.context, o.AuthPostAuthRefreshHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/register"] = auth.NewPostAuthRegister(o.context, o.AuthPostAuthRegisterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/verify-email"] = auth.NewPostAuthVerifyEmail(o.context, o.AuthPostAuthVerifyEmailHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/verify-email/resend"] = auth.NewPostAuthVerifyEmailResend(o.context, o.AuthPostAuthVerifyEmailResendHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/user"] = user_c_r_u_d.NewPostUser(o.context, o.UsercrudPostUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{guid}/mfa/recovery-codes"] = two_factor.NewPostUserGUIDMfaRecoveryCodes(o.context, o.TwofactorPostUserGUIDMfaRecoveryCodesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{guid}/mfa/totp"] = two_factor.NewPostUserGUIDMfaTotp(o.context, o.TwofactorPostUserGUIDMfaTotpHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{guid}/mfa/totp/confirm"] = two_factor.NewPostUserGUIDMfaTotpConfirm(o.context, o.TwofactorPostUserGUIDMfaTotpConfirmHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{guid}/restore"] = user_c_r_u_d.NewPostUserGUIDRestore(o.context, o.UsercrudPostUserGUIDRestoreHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhooks.NewPostWebhooks(o.context, o.WebhooksPostWebhooksHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/{id}/deliveries/{delivery_id}/redeliver"] = webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliver(o.context, o.WebhooksPostWebhooksIDDeliveriesDeliveryIDRedeliverHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/user/{guid}"] = user_c_r_u_d.NewPutUserGUID(o.context, o.UsercrudPutUserGUIDHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/webhooks/{id}"] = webhooks.NewPutWebhooksID(o.context, o.WebhooksPutWebhooksIDHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
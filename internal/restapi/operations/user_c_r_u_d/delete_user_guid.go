@@ -12,16 +12,16 @@ import (
 )
 
 // DeleteUserGUIDHandlerFunc turns a function with the right signature into a delete user GUID handler
-type DeleteUserGUIDHandlerFunc func(DeleteUserGUIDParams) middleware.Responder
+type DeleteUserGUIDHandlerFunc func(DeleteUserGUIDParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn DeleteUserGUIDHandlerFunc) Handle(params DeleteUserGUIDParams) middleware.Responder {
-	return fn(params)
+func (fn DeleteUserGUIDHandlerFunc) Handle(params DeleteUserGUIDParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // DeleteUserGUIDHandler interface for that can handle valid delete user GUID params
 type DeleteUserGUIDHandler interface {
-	Handle(DeleteUserGUIDParams) middleware.Responder
+	Handle(DeleteUserGUIDParams, interface{}) middleware.Responder
 }
 
 // NewDeleteUserGUID creates a new http.Handler for the delete user GUID operation
@@ -45,12 +45,25 @@ func (o *DeleteUserGUID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewDeleteUserGUIDParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -36,6 +36,10 @@ type DeleteUserGUIDParams struct {
 	  In: path
 	*/
 	GUID strfmt.UUID
+	/*ETag, полученный из GET /user/{guid}. Без заголовка запрос отклоняется с 428, при несовпадении с текущей версией пользователя - с 412
+	  In: header
+	*/
+	IfMatch *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +55,10 @@ func (o *DeleteUserGUIDParams) BindRequest(r *http.Request, route *middleware.Ma
 	if err := o.bindGUID(rGUID, rhkGUID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,3 +97,20 @@ func (o *DeleteUserGUIDParams) validateGUID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *DeleteUserGUIDParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
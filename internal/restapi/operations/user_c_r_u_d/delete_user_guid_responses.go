@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"otusgruz/internal/models"
 )
@@ -103,6 +104,344 @@ func (o *DeleteUserGUIDBadRequest) WriteResponse(rw http.ResponseWriter, produce
 	}
 }
 
+// DeleteUserGUIDUnauthorizedCode is the HTTP code returned for type DeleteUserGUIDUnauthorized
+const DeleteUserGUIDUnauthorizedCode int = 401
+
+/*
+DeleteUserGUIDUnauthorized Требуется аутентификация
+
+swagger:response deleteUserGuidUnauthorized
+*/
+type DeleteUserGUIDUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDUnauthorized creates DeleteUserGUIDUnauthorized with default headers values
+func NewDeleteUserGUIDUnauthorized() *DeleteUserGUIDUnauthorized {
+
+	return &DeleteUserGUIDUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete user Guid unauthorized response
+func (o *DeleteUserGUIDUnauthorized) WithPayload(payload *models.Error) *DeleteUserGUIDUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid unauthorized response
+func (o *DeleteUserGUIDUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDForbiddenCode is the HTTP code returned for type DeleteUserGUIDForbidden
+const DeleteUserGUIDForbiddenCode int = 403
+
+/*
+DeleteUserGUIDForbidden Недостаточно прав
+
+swagger:response deleteUserGuidForbidden
+*/
+type DeleteUserGUIDForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDForbidden creates DeleteUserGUIDForbidden with default headers values
+func NewDeleteUserGUIDForbidden() *DeleteUserGUIDForbidden {
+
+	return &DeleteUserGUIDForbidden{}
+}
+
+// WithPayload adds the payload to the delete user Guid forbidden response
+func (o *DeleteUserGUIDForbidden) WithPayload(payload *models.Error) *DeleteUserGUIDForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid forbidden response
+func (o *DeleteUserGUIDForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDNotFoundCode is the HTTP code returned for type DeleteUserGUIDNotFound
+const DeleteUserGUIDNotFoundCode int = 404
+
+/*
+DeleteUserGUIDNotFound Пользователь не найден
+
+swagger:response deleteUserGuidNotFound
+*/
+type DeleteUserGUIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDNotFound creates DeleteUserGUIDNotFound with default headers values
+func NewDeleteUserGUIDNotFound() *DeleteUserGUIDNotFound {
+
+	return &DeleteUserGUIDNotFound{}
+}
+
+// WithPayload adds the payload to the delete user Guid not found response
+func (o *DeleteUserGUIDNotFound) WithPayload(payload *models.Error) *DeleteUserGUIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid not found response
+func (o *DeleteUserGUIDNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDConflictCode is the HTTP code returned for type DeleteUserGUIDConflict
+const DeleteUserGUIDConflictCode int = 409
+
+/*
+DeleteUserGUIDConflict Пользователь уже удален
+
+swagger:response deleteUserGuidConflict
+*/
+type DeleteUserGUIDConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDConflict creates DeleteUserGUIDConflict with default headers values
+func NewDeleteUserGUIDConflict() *DeleteUserGUIDConflict {
+
+	return &DeleteUserGUIDConflict{}
+}
+
+// WithPayload adds the payload to the delete user Guid conflict response
+func (o *DeleteUserGUIDConflict) WithPayload(payload *models.Error) *DeleteUserGUIDConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid conflict response
+func (o *DeleteUserGUIDConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDPreconditionFailedCode is the HTTP code returned for type DeleteUserGUIDPreconditionFailed
+const DeleteUserGUIDPreconditionFailedCode int = 412
+
+/*
+DeleteUserGUIDPreconditionFailed Пользователь был изменен после получения ETag
+
+swagger:response deleteUserGuidPreconditionFailed
+*/
+type DeleteUserGUIDPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDPreconditionFailed creates DeleteUserGUIDPreconditionFailed with default headers values
+func NewDeleteUserGUIDPreconditionFailed() *DeleteUserGUIDPreconditionFailed {
+
+	return &DeleteUserGUIDPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the delete user Guid precondition failed response
+func (o *DeleteUserGUIDPreconditionFailed) WithPayload(payload *models.Error) *DeleteUserGUIDPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid precondition failed response
+func (o *DeleteUserGUIDPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDPreconditionRequiredCode is the HTTP code returned for type DeleteUserGUIDPreconditionRequired
+const DeleteUserGUIDPreconditionRequiredCode int = 428
+
+/*
+DeleteUserGUIDPreconditionRequired Не передан заголовок If-Match
+
+swagger:response deleteUserGuidPreconditionRequired
+*/
+type DeleteUserGUIDPreconditionRequired struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDPreconditionRequired creates DeleteUserGUIDPreconditionRequired with default headers values
+func NewDeleteUserGUIDPreconditionRequired() *DeleteUserGUIDPreconditionRequired {
+
+	return &DeleteUserGUIDPreconditionRequired{}
+}
+
+// WithPayload adds the payload to the delete user Guid precondition required response
+func (o *DeleteUserGUIDPreconditionRequired) WithPayload(payload *models.Error) *DeleteUserGUIDPreconditionRequired {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid precondition required response
+func (o *DeleteUserGUIDPreconditionRequired) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDPreconditionRequired) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(428)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGUIDTooManyRequestsCode is the HTTP code returned for type DeleteUserGUIDTooManyRequests
+const DeleteUserGUIDTooManyRequestsCode int = 429
+
+/*
+DeleteUserGUIDTooManyRequests Превышен лимит запросов
+
+swagger:response deleteUserGuidTooManyRequests
+*/
+type DeleteUserGUIDTooManyRequests struct {
+
+	/*через сколько секунд можно повторить запрос
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteUserGUIDTooManyRequests creates DeleteUserGUIDTooManyRequests with default headers values
+func NewDeleteUserGUIDTooManyRequests() *DeleteUserGUIDTooManyRequests {
+
+	return &DeleteUserGUIDTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete user Guid too many requests response
+func (o *DeleteUserGUIDTooManyRequests) WithRetryAfter(retryAfter int64) *DeleteUserGUIDTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete user Guid too many requests response
+func (o *DeleteUserGUIDTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete user Guid too many requests response
+func (o *DeleteUserGUIDTooManyRequests) WithPayload(payload *models.Error) *DeleteUserGUIDTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user Guid too many requests response
+func (o *DeleteUserGUIDTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGUIDTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DeleteUserGUIDInternalServerErrorCode is the HTTP code returned for type DeleteUserGUIDInternalServerError
 const DeleteUserGUIDInternalServerErrorCode int = 500
 
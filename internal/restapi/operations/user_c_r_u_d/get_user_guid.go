@@ -12,16 +12,16 @@ import (
 )
 
 // GetUserGUIDHandlerFunc turns a function with the right signature into a get user GUID handler
-type GetUserGUIDHandlerFunc func(GetUserGUIDParams) middleware.Responder
+type GetUserGUIDHandlerFunc func(GetUserGUIDParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GetUserGUIDHandlerFunc) Handle(params GetUserGUIDParams) middleware.Responder {
-	return fn(params)
+func (fn GetUserGUIDHandlerFunc) Handle(params GetUserGUIDParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GetUserGUIDHandler interface for that can handle valid get user GUID params
 type GetUserGUIDHandler interface {
-	Handle(GetUserGUIDParams) middleware.Responder
+	Handle(GetUserGUIDParams, interface{}) middleware.Responder
 }
 
 // NewGetUserGUID creates a new http.Handler for the get user GUID operation
@@ -45,12 +45,25 @@ func (o *GetUserGUID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewGetUserGUIDParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
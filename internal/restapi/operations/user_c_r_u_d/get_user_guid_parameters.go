@@ -9,17 +9,26 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewGetUserGUIDParams creates a new GetUserGUIDParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewGetUserGUIDParams() GetUserGUIDParams {
 
-	return GetUserGUIDParams{}
+	var (
+		// initialize parameters with default values
+
+		includeDeletedDefault = bool(false)
+	)
+
+	return GetUserGUIDParams{
+		IncludeDeleted: &includeDeletedDefault,
+	}
 }
 
 // GetUserGUIDParams contains all the bound params for the get user GUID operation
@@ -36,6 +45,11 @@ type GetUserGUIDParams struct {
 	  In: path
 	*/
 	GUID strfmt.UUID
+	/*выдавать удаленных пользователей, учитывается только для роли admin
+	  In: query
+	  Default: "false"
+	*/
+	IncludeDeleted *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -47,10 +61,17 @@ func (o *GetUserGUIDParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rGUID, rhkGUID, _ := route.Params.GetOK("guid")
 	if err := o.bindGUID(rGUID, rhkGUID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qIncludeDeleted, qhkIncludeDeleted, _ := qs.GetOK("include_deleted")
+	if err := o.bindIncludeDeleted(qIncludeDeleted, qhkIncludeDeleted, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,3 +110,27 @@ func (o *GetUserGUIDParams) validateGUID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIncludeDeleted binds and validates parameter IncludeDeleted from query.
+func (o *GetUserGUIDParams) bindIncludeDeleted(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetUserGUIDParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("include_deleted", "query", "bool", raw)
+	}
+	o.IncludeDeleted = &value
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"otusgruz/internal/models"
 )
@@ -23,6 +24,11 @@ swagger:response getUserGuidOK
 */
 type GetUserGUIDOK struct {
 
+	/*версия пользователя, передается в If-Match при изменении и удалении
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -35,6 +41,17 @@ func NewGetUserGUIDOK() *GetUserGUIDOK {
 	return &GetUserGUIDOK{}
 }
 
+// WithETag adds the eTag to the get user Guid o k response
+func (o *GetUserGUIDOK) WithETag(eTag string) *GetUserGUIDOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user Guid o k response
+func (o *GetUserGUIDOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get user Guid o k response
 func (o *GetUserGUIDOK) WithPayload(payload *models.UserData) *GetUserGUIDOK {
 	o.Payload = payload
@@ -49,6 +66,13 @@ func (o *GetUserGUIDOK) SetPayload(payload *models.UserData) {
 // WriteResponse to the client
 func (o *GetUserGUIDOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -103,6 +127,254 @@ func (o *GetUserGUIDBadRequest) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// GetUserGUIDUnauthorizedCode is the HTTP code returned for type GetUserGUIDUnauthorized
+const GetUserGUIDUnauthorizedCode int = 401
+
+/*
+GetUserGUIDUnauthorized Требуется аутентификация
+
+swagger:response getUserGuidUnauthorized
+*/
+type GetUserGUIDUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserGUIDUnauthorized creates GetUserGUIDUnauthorized with default headers values
+func NewGetUserGUIDUnauthorized() *GetUserGUIDUnauthorized {
+
+	return &GetUserGUIDUnauthorized{}
+}
+
+// WithPayload adds the payload to the get user Guid unauthorized response
+func (o *GetUserGUIDUnauthorized) WithPayload(payload *models.Error) *GetUserGUIDUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user Guid unauthorized response
+func (o *GetUserGUIDUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserGUIDUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserGUIDForbiddenCode is the HTTP code returned for type GetUserGUIDForbidden
+const GetUserGUIDForbiddenCode int = 403
+
+/*
+GetUserGUIDForbidden Недостаточно прав
+
+swagger:response getUserGuidForbidden
+*/
+type GetUserGUIDForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserGUIDForbidden creates GetUserGUIDForbidden with default headers values
+func NewGetUserGUIDForbidden() *GetUserGUIDForbidden {
+
+	return &GetUserGUIDForbidden{}
+}
+
+// WithPayload adds the payload to the get user Guid forbidden response
+func (o *GetUserGUIDForbidden) WithPayload(payload *models.Error) *GetUserGUIDForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user Guid forbidden response
+func (o *GetUserGUIDForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserGUIDForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserGUIDNotFoundCode is the HTTP code returned for type GetUserGUIDNotFound
+const GetUserGUIDNotFoundCode int = 404
+
+/*
+GetUserGUIDNotFound Пользователь не найден
+
+swagger:response getUserGuidNotFound
+*/
+type GetUserGUIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserGUIDNotFound creates GetUserGUIDNotFound with default headers values
+func NewGetUserGUIDNotFound() *GetUserGUIDNotFound {
+
+	return &GetUserGUIDNotFound{}
+}
+
+// WithPayload adds the payload to the get user Guid not found response
+func (o *GetUserGUIDNotFound) WithPayload(payload *models.Error) *GetUserGUIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user Guid not found response
+func (o *GetUserGUIDNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserGUIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserGUIDGoneCode is the HTTP code returned for type GetUserGUIDGone
+const GetUserGUIDGoneCode int = 410
+
+/*
+GetUserGUIDGone Пользователь удален
+
+swagger:response getUserGuidGone
+*/
+type GetUserGUIDGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserGUIDGone creates GetUserGUIDGone with default headers values
+func NewGetUserGUIDGone() *GetUserGUIDGone {
+
+	return &GetUserGUIDGone{}
+}
+
+// WithPayload adds the payload to the get user Guid gone response
+func (o *GetUserGUIDGone) WithPayload(payload *models.Error) *GetUserGUIDGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user Guid gone response
+func (o *GetUserGUIDGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserGUIDGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserGUIDTooManyRequestsCode is the HTTP code returned for type GetUserGUIDTooManyRequests
+const GetUserGUIDTooManyRequestsCode int = 429
+
+/*
+GetUserGUIDTooManyRequests Превышен лимит запросов
+
+swagger:response getUserGuidTooManyRequests
+*/
+type GetUserGUIDTooManyRequests struct {
+
+	/*через сколько секунд можно повторить запрос
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserGUIDTooManyRequests creates GetUserGUIDTooManyRequests with default headers values
+func NewGetUserGUIDTooManyRequests() *GetUserGUIDTooManyRequests {
+
+	return &GetUserGUIDTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the get user Guid too many requests response
+func (o *GetUserGUIDTooManyRequests) WithRetryAfter(retryAfter int64) *GetUserGUIDTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get user Guid too many requests response
+func (o *GetUserGUIDTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get user Guid too many requests response
+func (o *GetUserGUIDTooManyRequests) WithPayload(payload *models.Error) *GetUserGUIDTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user Guid too many requests response
+func (o *GetUserGUIDTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserGUIDTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetUserGUIDInternalServerErrorCode is the HTTP code returned for type GetUserGUIDInternalServerError
 const GetUserGUIDInternalServerErrorCode int = 500
 
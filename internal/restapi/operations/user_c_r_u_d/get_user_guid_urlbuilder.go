@@ -12,12 +12,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // GetUserGUIDURL generates an URL for the get user GUID operation
 type GetUserGUIDURL struct {
 	GUID strfmt.UUID
 
+	IncludeDeleted *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +60,18 @@ func (o *GetUserGUIDURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var includeDeletedQ string
+	if o.IncludeDeleted != nil {
+		includeDeletedQ = swag.FormatBool(*o.IncludeDeleted)
+	}
+	if includeDeletedQ != "" {
+		qs.Set("include_deleted", includeDeletedQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -12,16 +12,16 @@ import (
 )
 
 // PatchUserGUIDHandlerFunc turns a function with the right signature into a patch user GUID handler
-type PatchUserGUIDHandlerFunc func(PatchUserGUIDParams) middleware.Responder
+type PatchUserGUIDHandlerFunc func(PatchUserGUIDParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn PatchUserGUIDHandlerFunc) Handle(params PatchUserGUIDParams) middleware.Responder {
-	return fn(params)
+func (fn PatchUserGUIDHandlerFunc) Handle(params PatchUserGUIDParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // PatchUserGUIDHandler interface for that can handle valid patch user GUID params
 type PatchUserGUIDHandler interface {
-	Handle(PatchUserGUIDParams) middleware.Responder
+	Handle(PatchUserGUIDParams, interface{}) middleware.Responder
 }
 
 // NewPatchUserGUID creates a new http.Handler for the patch user GUID operation
@@ -45,12 +45,25 @@ func (o *PatchUserGUID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewPatchUserGUIDParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
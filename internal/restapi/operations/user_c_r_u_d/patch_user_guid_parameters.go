@@ -40,11 +40,15 @@ type PatchUserGUIDParams struct {
 	  In: path
 	*/
 	GUID strfmt.UUID
-	/*Параметры создания пользователя
+	/*ETag, полученный из GET /user/{guid}. Без заголовка запрос отклоняется с 428, при несовпадении с текущей версией пользователя - с 412
+	  In: header
+	*/
+	IfMatch *string
+	/*Изменяемые поля пользователя
 	  Required: true
 	  In: body
 	*/
-	Request *models.UserCreateParams
+	Request *models.UserPatchParams
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -61,9 +65,13 @@ func (o *PatchUserGUIDParams) BindRequest(r *http.Request, route *middleware.Mat
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
-		var body models.UserCreateParams
+		var body models.UserPatchParams
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if err == io.EOF {
 				res = append(res, errors.Required("request", "body", ""))
@@ -126,3 +134,20 @@ func (o *PatchUserGUIDParams) validateGUID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *PatchUserGUIDParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
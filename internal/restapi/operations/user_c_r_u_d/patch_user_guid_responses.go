@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"otusgruz/internal/models"
 )
@@ -103,6 +104,434 @@ func (o *PatchUserGUIDBadRequest) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// PatchUserGUIDUnauthorizedCode is the HTTP code returned for type PatchUserGUIDUnauthorized
+const PatchUserGUIDUnauthorizedCode int = 401
+
+/*
+PatchUserGUIDUnauthorized Требуется аутентификация
+
+swagger:response patchUserGuidUnauthorized
+*/
+type PatchUserGUIDUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDUnauthorized creates PatchUserGUIDUnauthorized with default headers values
+func NewPatchUserGUIDUnauthorized() *PatchUserGUIDUnauthorized {
+
+	return &PatchUserGUIDUnauthorized{}
+}
+
+// WithPayload adds the payload to the patch user Guid unauthorized response
+func (o *PatchUserGUIDUnauthorized) WithPayload(payload *models.Error) *PatchUserGUIDUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid unauthorized response
+func (o *PatchUserGUIDUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDForbiddenCode is the HTTP code returned for type PatchUserGUIDForbidden
+const PatchUserGUIDForbiddenCode int = 403
+
+/*
+PatchUserGUIDForbidden Недостаточно прав
+
+swagger:response patchUserGuidForbidden
+*/
+type PatchUserGUIDForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDForbidden creates PatchUserGUIDForbidden with default headers values
+func NewPatchUserGUIDForbidden() *PatchUserGUIDForbidden {
+
+	return &PatchUserGUIDForbidden{}
+}
+
+// WithPayload adds the payload to the patch user Guid forbidden response
+func (o *PatchUserGUIDForbidden) WithPayload(payload *models.Error) *PatchUserGUIDForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid forbidden response
+func (o *PatchUserGUIDForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDNotFoundCode is the HTTP code returned for type PatchUserGUIDNotFound
+const PatchUserGUIDNotFoundCode int = 404
+
+/*
+PatchUserGUIDNotFound Пользователь не найден
+
+swagger:response patchUserGuidNotFound
+*/
+type PatchUserGUIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDNotFound creates PatchUserGUIDNotFound with default headers values
+func NewPatchUserGUIDNotFound() *PatchUserGUIDNotFound {
+
+	return &PatchUserGUIDNotFound{}
+}
+
+// WithPayload adds the payload to the patch user Guid not found response
+func (o *PatchUserGUIDNotFound) WithPayload(payload *models.Error) *PatchUserGUIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid not found response
+func (o *PatchUserGUIDNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDConflictCode is the HTTP code returned for type PatchUserGUIDConflict
+const PatchUserGUIDConflictCode int = 409
+
+/*
+PatchUserGUIDConflict Email или имя пользователя уже заняты, в том числе удаленным пользователем, либо конфликт с текущим состоянием пользователя
+
+swagger:response patchUserGuidConflict
+*/
+type PatchUserGUIDConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDConflict creates PatchUserGUIDConflict with default headers values
+func NewPatchUserGUIDConflict() *PatchUserGUIDConflict {
+
+	return &PatchUserGUIDConflict{}
+}
+
+// WithPayload adds the payload to the patch user Guid conflict response
+func (o *PatchUserGUIDConflict) WithPayload(payload *models.Error) *PatchUserGUIDConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid conflict response
+func (o *PatchUserGUIDConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDGoneCode is the HTTP code returned for type PatchUserGUIDGone
+const PatchUserGUIDGoneCode int = 410
+
+/*
+PatchUserGUIDGone Пользователь удален
+
+swagger:response patchUserGuidGone
+*/
+type PatchUserGUIDGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDGone creates PatchUserGUIDGone with default headers values
+func NewPatchUserGUIDGone() *PatchUserGUIDGone {
+
+	return &PatchUserGUIDGone{}
+}
+
+// WithPayload adds the payload to the patch user Guid gone response
+func (o *PatchUserGUIDGone) WithPayload(payload *models.Error) *PatchUserGUIDGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid gone response
+func (o *PatchUserGUIDGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDPreconditionFailedCode is the HTTP code returned for type PatchUserGUIDPreconditionFailed
+const PatchUserGUIDPreconditionFailedCode int = 412
+
+/*
+PatchUserGUIDPreconditionFailed Пользователь был изменен после получения ETag
+
+swagger:response patchUserGuidPreconditionFailed
+*/
+type PatchUserGUIDPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDPreconditionFailed creates PatchUserGUIDPreconditionFailed with default headers values
+func NewPatchUserGUIDPreconditionFailed() *PatchUserGUIDPreconditionFailed {
+
+	return &PatchUserGUIDPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the patch user Guid precondition failed response
+func (o *PatchUserGUIDPreconditionFailed) WithPayload(payload *models.Error) *PatchUserGUIDPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid precondition failed response
+func (o *PatchUserGUIDPreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDUnprocessableEntityCode is the HTTP code returned for type PatchUserGUIDUnprocessableEntity
+const PatchUserGUIDUnprocessableEntityCode int = 422
+
+/*
+PatchUserGUIDUnprocessableEntity Ошибка валидации данных пользователя
+
+swagger:response patchUserGuidUnprocessableEntity
+*/
+type PatchUserGUIDUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDUnprocessableEntity creates PatchUserGUIDUnprocessableEntity with default headers values
+func NewPatchUserGUIDUnprocessableEntity() *PatchUserGUIDUnprocessableEntity {
+
+	return &PatchUserGUIDUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the patch user Guid unprocessable entity response
+func (o *PatchUserGUIDUnprocessableEntity) WithPayload(payload *models.Error) *PatchUserGUIDUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid unprocessable entity response
+func (o *PatchUserGUIDUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDPreconditionRequiredCode is the HTTP code returned for type PatchUserGUIDPreconditionRequired
+const PatchUserGUIDPreconditionRequiredCode int = 428
+
+/*
+PatchUserGUIDPreconditionRequired Не передан заголовок If-Match
+
+swagger:response patchUserGuidPreconditionRequired
+*/
+type PatchUserGUIDPreconditionRequired struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDPreconditionRequired creates PatchUserGUIDPreconditionRequired with default headers values
+func NewPatchUserGUIDPreconditionRequired() *PatchUserGUIDPreconditionRequired {
+
+	return &PatchUserGUIDPreconditionRequired{}
+}
+
+// WithPayload adds the payload to the patch user Guid precondition required response
+func (o *PatchUserGUIDPreconditionRequired) WithPayload(payload *models.Error) *PatchUserGUIDPreconditionRequired {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid precondition required response
+func (o *PatchUserGUIDPreconditionRequired) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDPreconditionRequired) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(428)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGUIDTooManyRequestsCode is the HTTP code returned for type PatchUserGUIDTooManyRequests
+const PatchUserGUIDTooManyRequestsCode int = 429
+
+/*
+PatchUserGUIDTooManyRequests Превышен лимит запросов
+
+swagger:response patchUserGuidTooManyRequests
+*/
+type PatchUserGUIDTooManyRequests struct {
+
+	/*через сколько секунд можно повторить запрос
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPatchUserGUIDTooManyRequests creates PatchUserGUIDTooManyRequests with default headers values
+func NewPatchUserGUIDTooManyRequests() *PatchUserGUIDTooManyRequests {
+
+	return &PatchUserGUIDTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the patch user Guid too many requests response
+func (o *PatchUserGUIDTooManyRequests) WithRetryAfter(retryAfter int64) *PatchUserGUIDTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the patch user Guid too many requests response
+func (o *PatchUserGUIDTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the patch user Guid too many requests response
+func (o *PatchUserGUIDTooManyRequests) WithPayload(payload *models.Error) *PatchUserGUIDTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user Guid too many requests response
+func (o *PatchUserGUIDTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGUIDTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // PatchUserGUIDInternalServerErrorCode is the HTTP code returned for type PatchUserGUIDInternalServerError
 const PatchUserGUIDInternalServerErrorCode int = 500
 
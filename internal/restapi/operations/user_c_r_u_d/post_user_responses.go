@@ -9,47 +9,319 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"otusgruz/internal/models"
 )
 
-// PostUserOKCode is the HTTP code returned for type PostUserOK
-const PostUserOKCode int = 200
+// PostUserCreatedCode is the HTTP code returned for type PostUserCreated
+const PostUserCreatedCode int = 201
 
 /*
-PostUserOK Успешное создание пользователя
+PostUserCreated Пользователь создан
 
-swagger:response postUserOK
+swagger:response postUserCreated
 */
-type PostUserOK struct {
+type PostUserCreated struct {
+
+	/*адрес созданного пользователя
+
+	 */
+	Location string `json:"Location"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserData `json:"body,omitempty"`
+}
+
+// NewPostUserCreated creates PostUserCreated with default headers values
+func NewPostUserCreated() *PostUserCreated {
+
+	return &PostUserCreated{}
+}
+
+// WithLocation adds the location to the post user created response
+func (o *PostUserCreated) WithLocation(location string) *PostUserCreated {
+	o.Location = location
+	return o
+}
+
+// SetLocation sets the location to the post user created response
+func (o *PostUserCreated) SetLocation(location string) {
+	o.Location = location
+}
+
+// WithPayload adds the payload to the post user created response
+func (o *PostUserCreated) WithPayload(payload *models.UserData) *PostUserCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post user created response
+func (o *PostUserCreated) SetPayload(payload *models.UserData) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostUserCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Location
+
+	location := o.Location
+	if location != "" {
+		rw.Header().Set("Location", location)
+	}
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostUserUnauthorizedCode is the HTTP code returned for type PostUserUnauthorized
+const PostUserUnauthorizedCode int = 401
+
+/*
+PostUserUnauthorized Требуется аутентификация
+
+swagger:response postUserUnauthorized
+*/
+type PostUserUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostUserUnauthorized creates PostUserUnauthorized with default headers values
+func NewPostUserUnauthorized() *PostUserUnauthorized {
+
+	return &PostUserUnauthorized{}
+}
+
+// WithPayload adds the payload to the post user unauthorized response
+func (o *PostUserUnauthorized) WithPayload(payload *models.Error) *PostUserUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post user unauthorized response
+func (o *PostUserUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostUserUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostUserForbiddenCode is the HTTP code returned for type PostUserForbidden
+const PostUserForbiddenCode int = 403
+
+/*
+PostUserForbidden Недостаточно прав
+
+swagger:response postUserForbidden
+*/
+type PostUserForbidden struct {
 
 	/*
 	  In: Body
 	*/
-	Payload *models.DefaultStatusResponse `json:"body,omitempty"`
+	Payload *models.Error `json:"body,omitempty"`
 }
 
-// NewPostUserOK creates PostUserOK with default headers values
-func NewPostUserOK() *PostUserOK {
+// NewPostUserForbidden creates PostUserForbidden with default headers values
+func NewPostUserForbidden() *PostUserForbidden {
 
-	return &PostUserOK{}
+	return &PostUserForbidden{}
 }
 
-// WithPayload adds the payload to the post user o k response
-func (o *PostUserOK) WithPayload(payload *models.DefaultStatusResponse) *PostUserOK {
+// WithPayload adds the payload to the post user forbidden response
+func (o *PostUserForbidden) WithPayload(payload *models.Error) *PostUserForbidden {
 	o.Payload = payload
 	return o
 }
 
-// SetPayload sets the payload to the post user o k response
-func (o *PostUserOK) SetPayload(payload *models.DefaultStatusResponse) {
+// SetPayload sets the payload to the post user forbidden response
+func (o *PostUserForbidden) SetPayload(payload *models.Error) {
 	o.Payload = payload
 }
 
 // WriteResponse to the client
-func (o *PostUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+func (o *PostUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostUserConflictCode is the HTTP code returned for type PostUserConflict
+const PostUserConflictCode int = 409
+
+/*
+PostUserConflict Email или имя пользователя уже заняты, в том числе удаленным пользователем, либо конфликт с текущим состоянием пользователя
+
+swagger:response postUserConflict
+*/
+type PostUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostUserConflict creates PostUserConflict with default headers values
+func NewPostUserConflict() *PostUserConflict {
+
+	return &PostUserConflict{}
+}
+
+// WithPayload adds the payload to the post user conflict response
+func (o *PostUserConflict) WithPayload(payload *models.Error) *PostUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post user conflict response
+func (o *PostUserConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostUserUnprocessableEntityCode is the HTTP code returned for type PostUserUnprocessableEntity
+const PostUserUnprocessableEntityCode int = 422
+
+/*
+PostUserUnprocessableEntity Ошибка валидации данных пользователя или повторное использование Idempotency-Key с другим телом
+
+swagger:response postUserUnprocessableEntity
+*/
+type PostUserUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostUserUnprocessableEntity creates PostUserUnprocessableEntity with default headers values
+func NewPostUserUnprocessableEntity() *PostUserUnprocessableEntity {
+
+	return &PostUserUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the post user unprocessable entity response
+func (o *PostUserUnprocessableEntity) WithPayload(payload *models.Error) *PostUserUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post user unprocessable entity response
+func (o *PostUserUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostUserUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostUserTooManyRequestsCode is the HTTP code returned for type PostUserTooManyRequests
+const PostUserTooManyRequestsCode int = 429
+
+/*
+PostUserTooManyRequests Превышен лимит запросов
+
+swagger:response postUserTooManyRequests
+*/
+type PostUserTooManyRequests struct {
+
+	/*через сколько секунд можно повторить запрос
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostUserTooManyRequests creates PostUserTooManyRequests with default headers values
+func NewPostUserTooManyRequests() *PostUserTooManyRequests {
+
+	return &PostUserTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the post user too many requests response
+func (o *PostUserTooManyRequests) WithRetryAfter(retryAfter int64) *PostUserTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the post user too many requests response
+func (o *PostUserTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the post user too many requests response
+func (o *PostUserTooManyRequests) WithPayload(payload *models.Error) *PostUserTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post user too many requests response
+func (o *PostUserTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostUserTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
 
-	rw.WriteHeader(200)
+	rw.WriteHeader(429)
 	if o.Payload != nil {
 		payload := o.Payload
 		if err := producer.Produce(rw, payload); err != nil {
@@ -0,0 +1,198 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+
+	"otusgruz/internal/restapi/operations"
+)
+
+// Типы тела PATCH /user/{guid}.
+const (
+	MIMEMergePatch = "application/merge-patch+json"
+	MIMEJSONPatch  = "application/json-patch+json"
+)
+
+// patchOperation операция JSON Patch (RFC 6902).
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// requiredFields поля, которые нельзя очистить: в users они NOT NULL. null для них в патче - ошибка,
+// а не отсутствие изменений, иначе клиент не узнал бы, что поле не очищено.
+var requiredFields = []string{"name", "occupation"} //nolint:gochecknoglobals
+
+// ConfigureConsumers подключает разбор JSON Patch и merge patch и отдачу ошибок проверки тела с кодом 422.
+// Тело application/json в PATCH /user/{guid} тоже разбирается как merge patch. Вызывается после назначения
+// обработчиков: AddMiddlewareFor оборачивает уже назначенный обработчик PATCH.
+func ConfigureConsumers(api *operations.RestServerAPI) {
+	api.RegisterConsumer(MIMEJSONPatch, jsonPatchConsumer())
+	api.RegisterConsumer(MIMEMergePatch, mergePatchConsumer())
+	api.RegisterConsumer(runtime.JSONMime, mergePatchConsumer())
+	api.ServeError = ServeError
+	api.AddMiddlewareFor(http.MethodPatch, "/user/{guid}", trackPatchNulls)
+}
+
+// patchBody тело PATCH /user/{guid}. Сгенерированная модель не отличает null от отсутствующего поля,
+// поэтому consumer запоминает в теле поля, явно равные null, а обработчик передает их сервису для очистки.
+type patchBody struct {
+	io.ReadCloser
+
+	nulls []string
+}
+
+func trackPatchNulls(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		r.Body = &patchBody{ReadCloser: r.Body} //nolint:exhaustruct
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// patchNulls поля тела запроса, равные null, в порядке имен.
+func patchNulls(r *http.Request) []string {
+	if body, ok := r.Body.(*patchBody); ok {
+		return body.nulls
+	}
+
+	return nil
+}
+
+// nullFields поля патча, равные null, в порядке имен.
+func nullFields(fields map[string]json.RawMessage) []string {
+	var nulls []string
+
+	for field, value := range fields {
+		if isNull(value) {
+			nulls = append(nulls, field)
+		}
+	}
+
+	slices.Sort(nulls)
+
+	return nulls
+}
+
+func isNull(value json.RawMessage) bool {
+	return string(bytes.TrimSpace(value)) == "null"
+}
+
+// ServeError отдает ошибки как errors.ServeError go-openapi. Ошибку проверки из consumer go-swagger
+// оборачивает в ParseError с кодом 400, такая ошибка отдается со своим кодом 422.
+func ServeError(rw http.ResponseWriter, r *http.Request, err error) {
+	var (
+		parseErr *oaerrors.ParseError
+		invalid  *oaerrors.Validation
+	)
+
+	if errors.As(err, &parseErr) && errors.As(parseErr.Reason, &invalid) {
+		err = invalid
+	}
+
+	oaerrors.ServeError(rw, r, err)
+}
+
+// mergePatchConsumer разбирает тело стандартным JSONConsumer. Тело PATCH /user/{guid} - merge patch (RFC 7396):
+// null для полей, которые нельзя очистить, отклоняется, остальные поля, равные null, запоминаются для очистки.
+func mergePatchConsumer() runtime.Consumer {
+	consumer := runtime.JSONConsumer()
+
+	return runtime.ConsumerFunc(func(r io.Reader, data interface{}) error {
+		body, ok := r.(*patchBody)
+		if !ok {
+			return consumer.Consume(r, data)
+		}
+
+		raw, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("reading merge patch: %w", err)
+		}
+
+		// тело, которое не является объектом, отклонит стандартный разбор
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(raw, &fields) == nil {
+			if err = checkRequired(fields); err != nil {
+				return err
+			}
+
+			body.nulls = nullFields(fields)
+		}
+
+		return consumer.Consume(bytes.NewReader(raw), data)
+	})
+}
+
+// checkRequired возвращает ошибку проверки для null в поле из requiredFields.
+func checkRequired(fields map[string]json.RawMessage) error {
+	for _, field := range requiredFields {
+		if value, ok := fields[field]; ok && isNull(value) {
+			return oaerrors.InvalidType(field, "body", "string", "null")
+		}
+	}
+
+	return nil
+}
+
+// jsonPatchConsumer сводит операции add и replace над полями верхнего уровня к эквивалентному
+// merge patch и декодирует его в тело запроса. Остальные операции не поддерживаются.
+func jsonPatchConsumer() runtime.Consumer {
+	return runtime.ConsumerFunc(func(r io.Reader, data interface{}) error {
+		var ops []patchOperation
+		if err := json.NewDecoder(r).Decode(&ops); err != nil {
+			return fmt.Errorf("decoding json patch: %w", err)
+		}
+
+		merge := make(map[string]json.RawMessage, len(ops))
+
+		for _, op := range ops {
+			if op.Op != "add" && op.Op != "replace" {
+				return fmt.Errorf("unsupported json patch operation %q", op.Op)
+			}
+
+			field, ok := strings.CutPrefix(op.Path, "/")
+			if !ok || field == "" || strings.Contains(field, "/") {
+				return fmt.Errorf("unsupported json patch path %q", op.Path)
+			}
+
+			if op.Value == nil {
+				return fmt.Errorf("json patch operation %q on %q has no value", op.Op, op.Path)
+			}
+
+			merge[pointerUnescaper.Replace(field)] = op.Value
+		}
+
+		if err := checkRequired(merge); err != nil {
+			return err
+		}
+
+		if body, ok := r.(*patchBody); ok {
+			body.nulls = nullFields(merge)
+		}
+
+		raw, err := json.Marshal(merge)
+		if err != nil {
+			return fmt.Errorf("encoding merge patch: %w", err)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+
+		if err = dec.Decode(data); err != nil {
+			return fmt.Errorf("applying json patch: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,146 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/restapi/operations"
+	"otusgruz/internal/restapi/operations/user_c_r_u_d"
+	"otusgruz/internal/service/api/auth"
+	"otusgruz/internal/service/api/user"
+)
+
+// patchRecorder user.Service, запоминающий аргументы PatchUser. Вызов остальных методов паникует.
+type patchRecorder struct {
+	user.Service
+
+	calls int
+	patch *models.UserPatchParams
+	clear []string
+}
+
+func (p *patchRecorder) PatchUser(_ context.Context, _ uuid.UUID, _ *int64, patch *models.UserPatchParams, clear []string, _ user.Actor) (*models.DefaultStatusResponse, error) {
+	p.calls++
+	p.patch, p.clear = patch, clear
+
+	return &models.DefaultStatusResponse{Code: "01", Message: "Successfully updated"}, nil
+}
+
+// newPatchAPI собирает API с обработчиком PATCH /user/{guid}, как build, без проверки доступа.
+func newPatchAPI(t *testing.T, userSrv user.Service) http.Handler {
+	t.Helper()
+
+	spec, err := loads.Spec("../../api/swagger/file.yaml")
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	api := operations.NewRestServerAPI(spec)
+	api.BearerAuth = func(string) (interface{}, error) {
+		return &auth.Principal{Subject: uuid.New()}, nil //nolint:exhaustruct
+	}
+	api.APIAuthorizer = runtime.AuthorizerFunc(func(*http.Request, interface{}) error { return nil })
+
+	handler := NewHandler(userSrv, nil, nil, nil, TrustedProxy{}, CookieSession{}) //nolint:exhaustruct
+	api.UsercrudPatchUserGUIDHandler = user_c_r_u_d.PatchUserGUIDHandlerFunc(handler.PatchUser)
+
+	ConfigureConsumers(api)
+
+	return api.Serve(nil)
+}
+
+func TestPatchUserNulls(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantEmail   string
+		wantClear   []string
+	}{
+		{
+			name:        "value",
+			contentType: MIMEMergePatch,
+			body:        `{"email":"alice@example.com"}`,
+			wantStatus:  http.StatusOK,
+			wantEmail:   "alice@example.com",
+		},
+		{
+			name:        "absent",
+			contentType: MIMEMergePatch,
+			body:        `{"first_name":"Alice"}`,
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "null clears optional fields",
+			contentType: MIMEMergePatch,
+			body:        `{"phone":null,"email":null,"first_name":"Alice"}`,
+			wantStatus:  http.StatusOK,
+			wantClear:   []string{"email", "phone"},
+		},
+		{
+			name:        "null in application/json",
+			contentType: runtime.JSONMime,
+			body:        `{"birth_date":null}`,
+			wantStatus:  http.StatusOK,
+			wantClear:   []string{"birth_date"},
+		},
+		{
+			name:        "null in json patch",
+			contentType: MIMEJSONPatch,
+			body:        `[{"op":"replace","path":"/username","value":null}]`,
+			wantStatus:  http.StatusOK,
+			wantClear:   []string{"username"},
+		},
+		{
+			name:        "null on required field",
+			contentType: MIMEMergePatch,
+			body:        `{"name":null}`,
+			wantStatus:  http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userSrv := &patchRecorder{} //nolint:exhaustruct
+			api := newPatchAPI(t, userSrv)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/user/"+uuid.NewString(), strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("If-Match", "*")
+
+			rw := httptest.NewRecorder()
+			api.ServeHTTP(rw, req)
+
+			if rw.Code != tt.wantStatus {
+				t.Fatalf("status %d (%s), want %d", rw.Code, rw.Body, tt.wantStatus)
+			}
+
+			if tt.wantStatus != http.StatusOK {
+				if userSrv.calls != 0 {
+					t.Errorf("service called %d times for rejected patch", userSrv.calls)
+				}
+
+				return
+			}
+
+			if email := userSrv.patch.Email; (email == nil) != (tt.wantEmail == "") || email != nil && email.String() != tt.wantEmail {
+				t.Errorf("email %v, want %q", email, tt.wantEmail)
+			}
+
+			if !slices.Equal(userSrv.clear, tt.wantClear) {
+				t.Errorf("cleared %v, want %v", userSrv.clear, tt.wantClear)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package restapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+
+	"otusgruz/internal/ratelimit"
+	"otusgruz/internal/service/api/auth"
+)
+
+// Заголовки ограничения частоты запросов (draft-ietf-httpapi-ratelimit-headers) и Retry-After ответа 429.
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+// Виды клиентов, по которым считаются запросы, значения метки client счетчика отклоненных запросов.
+const (
+	rateLimitClientUser   = "user"
+	rateLimitClientAPIKey = "api_key"
+	rateLimitClientIP     = "ip"
+)
+
+// rateLimitDefaultBucket операция в ключе бакета, общего для операций без собственного лимита.
+const rateLimitDefaultBucket = "*"
+
+var errRateLimited = errors.New("too many requests")
+
+// RateLimit настройки ограничения частоты запросов.
+type RateLimit struct {
+	Store ratelimit.Store
+	// Default лимит с одним бакетом на клиента для всех операций без собственного лимита.
+	Default ratelimit.Limit
+	// Operations собственные лимиты операций, ключ - метод и путь из спецификации, как в policy.
+	Operations map[string]ratelimit.Limit
+	// Exempt операции без лимита.
+	Exempt []string
+	// Rejected счетчик отклоненных запросов с метками operation и client.
+	Rejected *prometheus.CounterVec
+}
+
+// NewRateLimiter middleware для RoutesHandler: выполняется после выбора маршрута, но до аутентификации,
+// поэтому отклоненный запрос не доходит до базы. Клиент определяется по учетным данным, которые проверяются
+// без обращения к базе, иначе по IP адресу.
+func NewRateLimiter(
+	conf RateLimit, authn auth.Authenticator, trusted TrustedProxy, cookies CookieSession,
+) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			operation := operationKey(r, route)
+			if slices.Contains(conf.Exempt, operation) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			bucket, limit := operation, conf.Default
+			if own, ok := conf.Operations[operation]; ok {
+				limit = own
+			} else {
+				bucket = rateLimitDefaultBucket
+			}
+
+			var res ratelimit.Result
+
+			for _, client := range rateLimitClients(r, route, authn, trusted, cookies) {
+				taken, err := conf.Store.Take(r.Context(), bucket+"|"+client.kind+":"+client.id, limit)
+				if err != nil {
+					// недоступность хранилища лимитов не должна останавливать API
+					zerolog.Ctx(r.Context()).Err(err).Msg("rate limit check failed")
+					next.ServeHTTP(w, r)
+
+					return
+				}
+
+				// в заголовках - самый строгий из бакетов запроса
+				if res.Limit == 0 || !taken.Allowed || taken.Remaining < res.Remaining {
+					res = taken
+				}
+
+				if !taken.Allowed {
+					conf.Rejected.WithLabelValues(operation, client.kind).Inc()
+
+					break
+				}
+			}
+
+			w.Header().Set(HeaderRateLimitLimit, strconv.Itoa(res.Limit))
+			w.Header().Set(HeaderRateLimitRemaining, strconv.Itoa(res.Remaining))
+			w.Header().Set(HeaderRateLimitReset, strconv.FormatInt(ceilSeconds(res.Reset), 10))
+
+			if !res.Allowed {
+				w.Header().Set(HeaderRetryAfter, strconv.FormatInt(max(1, ceilSeconds(res.RetryAfter)), 10))
+				w.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+				w.WriteHeader(http.StatusTooManyRequests)
+
+				if err := runtime.JSONProducer().Produce(w, apiError(r.Context(), errRateLimited)); err != nil {
+					zerolog.Ctx(r.Context()).Err(err).Msg("write rate limit response")
+				}
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type rateLimitClient struct {
+	kind string
+	id   string
+}
+
+// rateLimitClients клиенты, лимиты которых расходует запрос. Access token и заголовки доверенного прокси
+// проверяются так же, как при аутентификации, неверные учетные данные считаются по IP.
+//
+// Ключ API проверяется только при аутентификации, с запросом к базе, поэтому учитывается лишь на операциях
+// со схемой APIKey и вместе с IP адресом: IP проверяется первым, и поддельные ключи не обходят его лимит
+// и не создают новых бакетов сверх него. Ключ учитывается по хэшу, чтобы нельзя было расходовать чужой лимит.
+func rateLimitClients(
+	r *http.Request, route *middleware.MatchedRoute, authn auth.Authenticator, trusted TrustedProxy, cookies CookieSession,
+) []rateLimitClient {
+	ip := rateLimitClient{kind: rateLimitClientIP, id: trusted.clientIP(r)}
+
+	if key := r.Header.Get(headerAPIKey); auth.IsAPIKey(key) && acceptsAPIKey(route) {
+		sum := sha256.Sum256([]byte(key))
+
+		return []rateLimitClient{ip, {kind: rateLimitClientAPIKey, id: hex.EncodeToString(sum[:16])}}
+	}
+
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		if principal, err := authn.Authenticate(r.Context(), token); err == nil {
+			return []rateLimitClient{{kind: rateLimitClientUser, id: principal.Subject.String()}}
+		}
+	}
+
+	if cookie, err := r.Cookie(CookieAccessToken); cookies.Enabled && err == nil && cookie.Value != "" {
+		if principal, err := authn.Authenticate(r.Context(), cookie.Value); err == nil {
+			return []rateLimitClient{{kind: rateLimitClientUser, id: principal.Subject.String()}}
+		}
+	}
+
+	if trusted.Enabled && trusted.trusts(r) {
+		if subject, err := uuid.Parse(r.Header.Get(HeaderUserID)); err == nil {
+			return []rateLimitClient{{kind: rateLimitClientUser, id: subject.String()}}
+		}
+	}
+
+	return []rateLimitClient{ip}
+}
+
+// acceptsAPIKey операция принимает схему APIKey.
+func acceptsAPIKey(route *middleware.MatchedRoute) bool {
+	for _, authenticator := range route.Authenticators {
+		if slices.Contains(authenticator.Schemes, schemeAPIKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,300 @@
+package restapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/ratelimit"
+	"otusgruz/internal/restapi/operations"
+	"otusgruz/internal/service/api/auth"
+)
+
+const (
+	testClientIP = "192.0.2.10"
+	testAPIKey   = "otg_0123456789abSECRETSECRETSECRETSECRETSECRET"
+)
+
+// recordingStore Store, запоминающий ключи бакетов, из которых списаны токены.
+type recordingStore struct {
+	ratelimit.Store
+
+	keys []string
+}
+
+func (s *recordingStore) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	s.keys = append(s.keys, key)
+
+	return s.Store.Take(ctx, key, limit)
+}
+
+// newRateLimitAPI маршрутизирует запросы по спецификации, как build, но вместо операций отвечает 200.
+func newRateLimitAPI(
+	t *testing.T, conf RateLimit, authn auth.Authenticator, trusted TrustedProxy, cookies CookieSession,
+) http.Handler {
+	t.Helper()
+
+	spec, err := loads.Spec("../../api/swagger/file.yaml")
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	api := operations.NewRestServerAPI(spec)
+	api.Init()
+
+	return api.Context().RoutesHandler(func(http.Handler) http.Handler {
+		return NewRateLimiter(conf, authn, trusted, cookies)(ok)
+	})
+}
+
+func newRejectedCounter(t *testing.T) (*prometheus.CounterVec, *prometheus.Registry) {
+	t.Helper()
+
+	rejected := prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+		Name: "rate_limit_rejected_total",
+	}, []string{"operation", "client"})
+
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(rejected); err != nil {
+		t.Fatalf("register counter: %v", err)
+	}
+
+	return rejected, reg
+}
+
+func TestRateLimiterKeys(t *testing.T) {
+	principal := &auth.Principal{Subject: uuid.New()} //nolint:exhaustruct
+	headerUser := uuid.New()
+
+	keySum := sha256.Sum256([]byte(testAPIKey))
+	apiKeyClient := "api_key:" + hex.EncodeToString(keySum[:16])
+
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("parse cidr: %v", err)
+	}
+
+	trusted := TrustedProxy{Enabled: true, Networks: []*net.IPNet{proxies}}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		remoteAddr string
+		header     http.Header
+		cookies    []*http.Cookie
+		cookieMode bool
+		wantKeys   []string
+	}{
+		{
+			name:     "ip",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			wantKeys: []string{"*|ip:" + testClientIP},
+		},
+		{
+			name:     "bearer",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			header:   http.Header{"Authorization": {"Bearer " + validToken}},
+			wantKeys: []string{"*|user:" + principal.Subject.String()},
+		},
+		{
+			name:     "invalid bearer counts by ip",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			header:   http.Header{"Authorization": {"Bearer forged"}},
+			wantKeys: []string{"*|ip:" + testClientIP},
+		},
+		{
+			name:       "session cookie",
+			method:     http.MethodGet,
+			path:       "/api/user",
+			cookies:    []*http.Cookie{{Name: CookieAccessToken, Value: validToken}},
+			cookieMode: true,
+			wantKeys:   []string{"*|user:" + principal.Subject.String()},
+		},
+		{
+			name:     "session cookie in bearer mode counts by ip",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			cookies:  []*http.Cookie{{Name: CookieAccessToken, Value: validToken}},
+			wantKeys: []string{"*|ip:" + testClientIP},
+		},
+		{
+			name:     "api key counts by ip and key",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			header:   http.Header{headerAPIKey: {testAPIKey}, "Authorization": {"Bearer " + validToken}},
+			wantKeys: []string{"*|ip:" + testClientIP, "*|" + apiKeyClient},
+		},
+		{
+			name:     "api key on operation without the scheme counts by ip",
+			method:   http.MethodPost,
+			path:     "/api/auth/login",
+			header:   http.Header{headerAPIKey: {testAPIKey}},
+			wantKeys: []string{"POST /auth/login|ip:" + testClientIP},
+		},
+		{
+			name:       "trusted proxy user",
+			method:     http.MethodGet,
+			path:       "/api/user",
+			remoteAddr: "10.0.0.1:4000",
+			header:     http.Header{HeaderUserID: {headerUser.String()}},
+			wantKeys:   []string{"*|user:" + headerUser.String()},
+		},
+		{
+			name:       "trusted proxy forwarded ip",
+			method:     http.MethodGet,
+			path:       "/api/user",
+			remoteAddr: "10.0.0.1:4000",
+			header:     http.Header{"X-Forwarded-For": {testClientIP + ", 10.0.0.2"}},
+			wantKeys:   []string{"*|ip:" + testClientIP},
+		},
+		{
+			name:     "user header from untrusted address counts by ip",
+			method:   http.MethodGet,
+			path:     "/api/user",
+			header:   http.Header{HeaderUserID: {headerUser.String()}},
+			wantKeys: []string{"*|ip:" + testClientIP},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &recordingStore{Store: ratelimit.NewMemory()} //nolint:exhaustruct
+			rejected, _ := newRejectedCounter(t)
+
+			conf := RateLimit{
+				Store:      store,
+				Default:    ratelimit.Limit{Requests: 10, Period: time.Minute},
+				Operations: map[string]ratelimit.Limit{"POST /auth/login": {Requests: 5, Period: time.Minute}},
+				Exempt:     nil,
+				Rejected:   rejected,
+			}
+
+			cookies := CookieSession{Enabled: tt.cookieMode} //nolint:exhaustruct
+			api := newRateLimitAPI(t, conf, tokenAuthenticator{principal: principal}, trusted, cookies)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = testClientIP + ":5000"
+
+			if tt.remoteAddr != "" {
+				req.RemoteAddr = tt.remoteAddr
+			}
+
+			for name, values := range tt.header {
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+
+			for _, cookie := range tt.cookies {
+				req.AddCookie(cookie)
+			}
+
+			rec := httptest.NewRecorder()
+			api.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("status %d, want %d", rec.Code, http.StatusOK)
+			}
+
+			if !slices.Equal(store.keys, tt.wantKeys) {
+				t.Errorf("buckets %q, want %q", store.keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestRateLimiterRejects(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		wantStatus    int
+		wantRemaining string
+		wantRetry     string
+	}{
+		{name: "within limit", wantStatus: http.StatusOK, wantRemaining: "0"},
+		{name: "over limit", wantStatus: http.StatusTooManyRequests, wantRemaining: "0", wantRetry: "30"},
+		{name: "exempt operation", path: "/api/health/live", wantStatus: http.StatusOK},
+	}
+
+	rejected, reg := newRejectedCounter(t)
+
+	// один запрос в 30 секунд, запросы идут подряд, поэтому бакет не успевает пополниться
+	conf := RateLimit{
+		Store:      ratelimit.NewMemory(),
+		Default:    ratelimit.Limit{Requests: 1, Period: 30 * time.Second},
+		Operations: nil,
+		Exempt:     []string{"GET /health/live"},
+		Rejected:   rejected,
+	}
+
+	api := newRateLimitAPI(t, conf, tokenAuthenticator{}, TrustedProxy{}, CookieSession{}) //nolint:exhaustruct
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path
+			if path == "" {
+				path = "/api/user"
+			}
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.RemoteAddr = testClientIP + ":5000"
+
+			rec := httptest.NewRecorder()
+			api.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			if got := rec.Header().Get(HeaderRateLimitRemaining); got != tt.wantRemaining {
+				t.Errorf("%s %q, want %q", HeaderRateLimitRemaining, got, tt.wantRemaining)
+			}
+
+			if got := rec.Header().Get(HeaderRetryAfter); got != tt.wantRetry {
+				t.Errorf("%s %q, want %q", HeaderRetryAfter, got, tt.wantRetry)
+			}
+
+			if tt.wantStatus != http.StatusTooManyRequests {
+				return
+			}
+
+			var body models.Error
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+
+			if body.Code != CodeRateLimited {
+				t.Errorf("error code %q, want %q", body.Code, CodeRateLimited)
+			}
+		})
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+
+	if len(families) != 1 || len(families[0].GetMetric()) != 1 || families[0].GetMetric()[0].GetCounter().GetValue() != 1 {
+		t.Errorf("rejected counter %v, want one rejection", families)
+	}
+}
@@ -0,0 +1,61 @@
+package restapi
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/restapi/operations/sessions"
+	"otusgruz/internal/service/api/auth"
+)
+
+// client сведения об устройстве для сессии, IP определяется с учетом доверенного прокси.
+func (h *Handler) client(r *http.Request) auth.Client {
+	return auth.Client{
+		UserAgent: r.UserAgent(),
+		IP:        h.trusted.clientIP(r),
+	}
+}
+
+func (h *Handler) ListSessions(params sessions.GetUserGUIDSessionsParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return sessions.NewGetUserGUIDSessionsBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.authSrv.ListSessions(ctx, userGUID, principalOf(principal).SessionID)
+	if err != nil {
+		return sessions.NewGetUserGUIDSessionsInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return sessions.NewGetUserGUIDSessionsOK().WithPayload(res)
+}
+
+func (h *Handler) RevokeSession(params sessions.DeleteUserGUIDSessionsIDParams, _ interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	userGUID, err := uuid.Parse(params.GUID.String())
+	if err != nil {
+		return sessions.NewDeleteUserGUIDSessionsIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return sessions.NewDeleteUserGUIDSessionsIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	if err = h.authSrv.RevokeSession(ctx, userGUID, id); err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return sessions.NewDeleteUserGUIDSessionsIDNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return sessions.NewDeleteUserGUIDSessionsIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return sessions.NewDeleteUserGUIDSessionsIDOK().WithPayload(&models.DefaultStatusResponse{Code: "01", Message: "Session revoked"})
+}
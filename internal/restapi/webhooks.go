@@ -0,0 +1,171 @@
+package restapi
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/restapi/operations/webhooks"
+	"otusgruz/internal/service/api/auth"
+	"otusgruz/internal/service/api/webhook"
+)
+
+func (h *Handler) ListWebhooks(params webhooks.GetWebhooksParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.webhookSrv.ListWebhooks(ctx, webhookOwner(principal))
+	if err != nil {
+		return webhooks.NewGetWebhooksInternalServerError().WithPayload(apiError(ctx, err))
+	}
+
+	return webhooks.NewGetWebhooksOK().WithPayload(res)
+}
+
+func (h *Handler) CreateWebhook(params webhooks.PostWebhooksParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	res, err := h.webhookSrv.CreateWebhook(ctx, principalOf(principal).Subject, params.Request)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeValidation:
+			return webhooks.NewPostWebhooksUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewPostWebhooksInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	location := (&webhooks.GetWebhooksIDURL{ID: res.ID}).String() //nolint:exhaustruct
+
+	return webhooks.NewPostWebhooksCreated().WithLocation(location).WithPayload(res)
+}
+
+func (h *Handler) GetWebhook(params webhooks.GetWebhooksIDParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return webhooks.NewGetWebhooksIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.webhookSrv.GetWebhook(ctx, id, webhookOwner(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return webhooks.NewGetWebhooksIDNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewGetWebhooksIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return webhooks.NewGetWebhooksIDOK().WithPayload(res)
+}
+
+func (h *Handler) UpdateWebhook(params webhooks.PutWebhooksIDParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return webhooks.NewPutWebhooksIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.webhookSrv.UpdateWebhook(ctx, id, webhookOwner(principal), params.Request)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return webhooks.NewPutWebhooksIDNotFound().WithPayload(apiError(ctx, err))
+		case CodeValidation:
+			return webhooks.NewPutWebhooksIDUnprocessableEntity().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewPutWebhooksIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return webhooks.NewPutWebhooksIDOK().WithPayload(res)
+}
+
+func (h *Handler) DeleteWebhook(params webhooks.DeleteWebhooksIDParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return webhooks.NewDeleteWebhooksIDBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.webhookSrv.DeleteWebhook(ctx, id, webhookOwner(principal))
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return webhooks.NewDeleteWebhooksIDNotFound().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewDeleteWebhooksIDInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return webhooks.NewDeleteWebhooksIDOK().WithPayload(res)
+}
+
+func (h *Handler) WebhookDeliveries(params webhooks.GetWebhooksIDDeliveriesParams, principal interface{}) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return webhooks.NewGetWebhooksIDDeliveriesBadRequest().WithPayload(badRequest(err))
+	}
+
+	filter := webhook.DeliveryFilter{
+		Status: params.Status,
+		Limit:  *params.Limit,
+	}
+
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
+	}
+
+	res, err := h.webhookSrv.ListDeliveries(ctx, id, webhookOwner(principal), filter)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return webhooks.NewGetWebhooksIDDeliveriesNotFound().WithPayload(apiError(ctx, err))
+		case CodeBadRequest:
+			return webhooks.NewGetWebhooksIDDeliveriesBadRequest().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewGetWebhooksIDDeliveriesInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return webhooks.NewGetWebhooksIDDeliveriesOK().WithPayload(res)
+}
+
+func (h *Handler) RedeliverWebhook(
+	params webhooks.PostWebhooksIDDeliveriesDeliveryIDRedeliverParams, principal interface{},
+) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+
+	id, err := uuid.Parse(params.ID.String())
+	if err != nil {
+		return webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliverBadRequest().WithPayload(badRequest(err))
+	}
+
+	res, err := h.webhookSrv.Redeliver(ctx, id, webhookOwner(principal), params.DeliveryID)
+	if err != nil {
+		switch errorCode(err) {
+		case CodeNotFound:
+			return webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliverNotFound().WithPayload(apiError(ctx, err))
+		case CodeConflict:
+			return webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliverConflict().WithPayload(apiError(ctx, err))
+		default:
+			return webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliverInternalServerError().WithPayload(apiError(ctx, err))
+		}
+	}
+
+	return webhooks.NewPostWebhooksIDDeliveriesDeliveryIDRedeliverOK().WithPayload(res)
+}
+
+// webhookOwner ограничивает операции подписками субъекта, роль admin видит все подписки.
+func webhookOwner(principal interface{}) uuid.NullUUID {
+	p := principalOf(principal)
+	if p.HasRole(auth.RoleAdmin) {
+		return uuid.NullUUID{} //nolint:exhaustruct
+	}
+
+	return uuid.NullUUID{UUID: p.Subject, Valid: true}
+}
@@ -0,0 +1,217 @@
+package seed
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/service/api/user"
+)
+
+// FS фикстуры по окружениям: fixtures/<APP_ENV>/*.yaml|*.yml|*.json.
+//
+//go:embed fixtures
+var FS embed.FS
+
+const fixturesDir = "fixtures"
+
+var ErrUnsupportedFormat = errors.New("unsupported fixture format")
+
+type Fixtures struct {
+	Users []User `json:"users" yaml:"users"`
+}
+
+type User struct {
+	GUID       string `json:"guid"       yaml:"guid"`
+	Name       string `json:"name"       yaml:"name"`
+	Occupation string `json:"occupation" yaml:"occupation"`
+	Username   string `json:"username"   yaml:"username"`
+	Email      string `json:"email"      yaml:"email"`
+}
+
+// Embedded возвращает встроенный набор фикстур окружения env. Отсутствие набора не ошибка.
+func Embedded(env string) (Fixtures, error) {
+	return load(FS, path.Join(fixturesDir, env))
+}
+
+// FromPath загружает фикстуры из файла или из всех файлов каталога.
+func FromPath(p string) (Fixtures, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return Fixtures{}, fmt.Errorf("stat fixtures: %w", err)
+	}
+
+	if !info.IsDir() {
+		return load(os.DirFS(filepath.Dir(p)), filepath.Base(p))
+	}
+
+	return load(os.DirFS(p), ".")
+}
+
+func load(fsys fs.FS, name string) (Fixtures, error) {
+	var res Fixtures
+
+	info, err := fs.Stat(fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return res, nil
+	}
+
+	if err != nil {
+		return res, fmt.Errorf("stat %s: %w", name, err)
+	}
+
+	files := []string{name}
+
+	if info.IsDir() {
+		entries, err := fs.ReadDir(fsys, name)
+		if err != nil {
+			return res, fmt.Errorf("read dir %s: %w", name, err)
+		}
+
+		files = files[:0]
+
+		for _, entry := range entries {
+			if !entry.IsDir() && isFixture(entry.Name()) {
+				files = append(files, path.Join(name, entry.Name()))
+			}
+		}
+
+		sort.Strings(files)
+	}
+
+	for _, file := range files {
+		f, err := parse(fsys, file)
+		if err != nil {
+			return res, err
+		}
+
+		res.Users = append(res.Users, f.Users...)
+	}
+
+	return res, nil
+}
+
+func isFixture(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func parse(fsys fs.FS, file string) (Fixtures, error) {
+	var res Fixtures
+
+	raw, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return res, fmt.Errorf("read %s: %w", file, err)
+	}
+
+	switch strings.ToLower(path.Ext(file)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(raw))
+		dec.KnownFields(true)
+		err = dec.Decode(&res)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&res)
+	default:
+		return res, fmt.Errorf("%w: %s", ErrUnsupportedFormat, file)
+	}
+
+	if err != nil {
+		return res, fmt.Errorf("decode %s: %w", file, err)
+	}
+
+	return res, nil
+}
+
+type userService interface {
+	EnsureUser(ctx context.Context, guid uuid.UUID, info *models.UserCreateParams) (bool, error)
+	PurgeUser(ctx context.Context, guid uuid.UUID) error
+}
+
+type Seeder struct {
+	users userService
+}
+
+func NewSeeder(users userService) *Seeder {
+	return &Seeder{users: users}
+}
+
+// Apply создает отсутствующие записи. Уже существующие guid пропускаются, поэтому повторный запуск безопасен.
+func (s *Seeder) Apply(ctx context.Context, fixtures Fixtures) (int, error) {
+	created := 0
+
+	for _, u := range fixtures.Users {
+		guid, err := uuid.Parse(u.GUID)
+		if err != nil {
+			return created, fmt.Errorf("user %q: invalid guid: %w", u.GUID, err)
+		}
+
+		info := &models.UserCreateParams{ //nolint:exhaustruct
+			Name:       u.Name,
+			Occupation: u.Occupation,
+			Username:   u.Username,
+			Email:      strfmt.Email(u.Email),
+		}
+		if err = info.Validate(strfmt.Default); err != nil {
+			return created, fmt.Errorf("user %s: %w", guid, err)
+		}
+
+		ok, err := s.users.EnsureUser(ctx, guid, info)
+		if err != nil {
+			return created, fmt.Errorf("user %s: %w", guid, err)
+		}
+
+		if ok {
+			created++
+		}
+
+		zerolog.Ctx(ctx).Debug().Stringer("guid", guid).Bool("created", ok).Msg("seed user")
+	}
+
+	return created, nil
+}
+
+// Purge удаляет записи, перечисленные в фикстурах.
+func (s *Seeder) Purge(ctx context.Context, fixtures Fixtures) (int, error) {
+	purged := 0
+
+	for _, u := range fixtures.Users {
+		guid, err := uuid.Parse(u.GUID)
+		if err != nil {
+			return purged, fmt.Errorf("user %q: invalid guid: %w", u.GUID, err)
+		}
+
+		err = s.users.PurgeUser(ctx, guid)
+		if errors.Is(err, user.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return purged, fmt.Errorf("user %s: %w", guid, err)
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+)
+
+const (
+	// apiKeyMarker начало всех ключей, по нему ключ легко найти при утечке в логах или репозитории.
+	apiKeyMarker = "otg_"
+	// apiKeyPrefixBytes случайная часть открытого префикса, по которому ключ ищется в базе.
+	apiKeyPrefixBytes = 6
+	// apiKeySecretBytes секретная часть ключа до base64 кодирования.
+	apiKeySecretBytes = 32
+	// apiKeyPrefixLen длина открытого префикса вместе с apiKeyMarker.
+	apiKeyPrefixLen = len(apiKeyMarker) + 2*apiKeyPrefixBytes
+)
+
+// APIKeyAuthenticator проверяет ключи API межсервисных клиентов.
+type APIKeyAuthenticator interface {
+	// AuthenticateAPIKey возвращает субъекта с Subject и APIKeyID, равными идентификатору ключа.
+	// Ключ со scope admin получает роль admin.
+	AuthenticateAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+func (s *service) CreateAPIKey(ctx context.Context, createdBy uuid.UUID, params *models.APIKeyParams) (*models.APIKey, error) {
+	for _, scope := range params.Scopes {
+		if !isKnownScope(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKey, scope)
+		}
+	}
+
+	var expiresAt sql.NullTime
+
+	if params.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: time.Time(*params.ExpiresAt), Valid: true}
+
+		if !expiresAt.Time.After(time.Now()) {
+			return nil, fmt.Errorf("%w: expires_at must be in the future", ErrInvalidAPIKey)
+		}
+	}
+
+	prefix, key, err := newAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.InsertAPIKey(ctx, query.InsertAPIKeyParams{
+		ID:        uuid.New(),
+		Name:      params.Name,
+		Prefix:    prefix,
+		KeyHash:   hashToken(key),
+		Scopes:    params.Scopes,
+		CreatedBy: uuid.NullUUID{UUID: createdBy, Valid: createdBy != uuid.Nil},
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating api key: %w", err)
+	}
+
+	res := toAPIKey(row)
+	res.Key = key
+
+	return res, nil
+}
+
+func (s *service) ListAPIKeys(ctx context.Context) (*models.APIKeyList, error) {
+	rows, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing api keys: %w", err)
+	}
+
+	res := &models.APIKeyList{Items: make([]*models.APIKey, 0, len(rows))}
+
+	for _, row := range rows {
+		res.Items = append(res.Items, toAPIKey(row))
+	}
+
+	return res, nil
+}
+
+func (s *service) GetAPIKey(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
+	row, err := s.repo.GetAPIKey(ctx, id)
+	if errors.Is(err, errNotFound) {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting api key: %w", err)
+	}
+
+	return toAPIKey(row), nil
+}
+
+func (s *service) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	revoked, err := s.repo.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return fmt.Errorf("revoking api key: %w", err)
+	}
+
+	if revoked == 0 {
+		return fmt.Errorf("%w or already revoked", ErrAPIKeyNotFound)
+	}
+
+	return nil
+}
+
+func (s *service) AuthenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	if !IsAPIKey(key) {
+		return nil, fmt.Errorf("%w: malformed api key", ErrInvalidToken)
+	}
+
+	row, err := s.repo.GetAPIKeyByPrefix(ctx, key[:apiKeyPrefixLen])
+	if errors.Is(err, errNotFound) {
+		return nil, fmt.Errorf("%w: unknown api key", ErrInvalidToken)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting api key: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashToken(key)), []byte(row.KeyHash)) != 1 {
+		return nil, fmt.Errorf("%w: unknown api key", ErrInvalidToken)
+	}
+
+	if row.RevokedAt.Valid || (row.ExpiresAt.Valid && !row.ExpiresAt.Time.After(time.Now())) {
+		return nil, fmt.Errorf("%w: api key is revoked or expired", ErrInvalidToken)
+	}
+
+	// запрос обновляет last_used_at не чаще раза в минуту, чтобы частые вызовы не писали в базу каждый раз
+	if err = s.repo.TouchAPIKey(ctx, row.ID); err != nil {
+		return nil, fmt.Errorf("touching api key: %w", err)
+	}
+
+	res := &Principal{Subject: row.ID, APIKeyID: row.ID, Scopes: row.Scopes} //nolint:exhaustruct
+	if res.HasScope(ScopeAdmin) {
+		res.Roles = []string{RoleAdmin}
+	}
+
+	return res, nil
+}
+
+// IsAPIKey проверяет только формат ключа, без обращения к базе.
+func IsAPIKey(key string) bool {
+	return len(key) > apiKeyPrefixLen && strings.HasPrefix(key, apiKeyMarker)
+}
+
+// newAPIKey возвращает открытый префикс и ключ целиком, префикс - начало ключа:
+// otg_<12 hex>_<base64url секрета>.
+func newAPIKey() (string, string, error) {
+	buf := make([]byte, apiKeyPrefixBytes+apiKeySecretBytes)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("generate api key: %w", err)
+	}
+
+	prefix := apiKeyMarker + hex.EncodeToString(buf[:apiKeyPrefixBytes])
+
+	return prefix, prefix + "_" + base64.RawURLEncoding.EncodeToString(buf[apiKeyPrefixBytes:]), nil
+}
+
+func toAPIKey(row query.ApiKey) *models.APIKey {
+	res := &models.APIKey{ //nolint:exhaustruct
+		ID:         strfmt.UUID(row.ID.String()),
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		Scopes:     row.Scopes,
+		CreatedAt:  strfmt.DateTime(row.CreatedAt),
+		ExpiresAt:  nullDateTime(row.ExpiresAt),
+		LastUsedAt: nullDateTime(row.LastUsedAt),
+		RevokedAt:  nullDateTime(row.RevokedAt),
+	}
+
+	if row.CreatedBy.Valid {
+		createdBy := strfmt.UUID(row.CreatedBy.UUID.String())
+		res.CreatedBy = &createdBy
+	}
+
+	if res.Scopes == nil {
+		res.Scopes = []string{}
+	}
+
+	return res
+}
+
+func nullDateTime(v sql.NullTime) *strfmt.DateTime {
+	if !v.Valid {
+		return nil
+	}
+
+	res := strfmt.DateTime(v.Time)
+
+	return &res
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/bcrypt"
+
+	"otusgruz/internal/mailer"
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+	users "otusgruz/internal/service/api/user"
+)
+
+// Назначения токенов из писем, совпадают с ограничением email_tokens.purpose.
+const (
+	purposeVerifyEmail   = "verify_email"
+	purposeResetPassword = "reset_password"
+)
+
+type emailLinks struct {
+	baseURL   string
+	verifyTTL time.Duration
+	resetTTL  time.Duration
+}
+
+func (l emailLinks) ttl(purpose string) time.Duration {
+	if purpose == purposeResetPassword {
+		return l.resetTTL
+	}
+
+	return l.verifyTTL
+}
+
+// message письмо со ссылкой на страницу фронтенда, которая передает токен в API.
+func (l emailLinks) message(purpose, to, token string) mailer.Message {
+	switch purpose {
+	case purposeResetPassword:
+		return mailer.Message{
+			To:      to,
+			Subject: "Сброс пароля",
+			Text: fmt.Sprintf("Для установки нового пароля перейдите по ссылке:\n\n%s/reset-password?token=%s\n\n"+
+				"Ссылка действует %s. Если вы не запрашивали сброс пароля, проигнорируйте это письмо.\n",
+				l.baseURL, url.QueryEscape(token), formatTTL(l.resetTTL)),
+		}
+	default:
+		return mailer.Message{
+			To:      to,
+			Subject: "Подтверждение адреса электронной почты",
+			Text: fmt.Sprintf("Для подтверждения адреса перейдите по ссылке:\n\n%s/verify-email?token=%s\n\n"+
+				"Ссылка действует %s.\n",
+				l.baseURL, url.QueryEscape(token), formatTTL(l.verifyTTL)),
+		}
+	}
+}
+
+// formatTTL срок действия ссылки для текста письма с точностью до часа или минуты.
+func formatTTL(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d ч", d/time.Hour)
+	}
+
+	return fmt.Sprintf("%d мин", d/time.Minute)
+}
+
+func (s *service) VerifyEmail(ctx context.Context, token string) error {
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		used, err := tx.UseEmailToken(ctx, query.UseEmailTokenParams{
+			TokenHash: hashToken(token),
+			Purpose:   purposeVerifyEmail,
+		})
+		if errors.Is(err, errNotFound) {
+			return ErrInvalidEmailToken
+		}
+
+		if err != nil {
+			return err
+		}
+
+		before, err := tx.GetUser(ctx, used.UserGuid)
+		if err != nil {
+			return err
+		}
+
+		// email мог измениться после отправки письма, тогда подтверждать нечего
+		verified, err := tx.MarkEmailVerified(ctx, query.MarkEmailVerifiedParams{Guid: used.UserGuid, Email: used.Email})
+		if err != nil {
+			return err
+		}
+
+		if verified == 0 {
+			return ErrInvalidEmailToken
+		}
+
+		after, err := tx.GetUser(ctx, used.UserGuid)
+		if err != nil {
+			return err
+		}
+
+		actor := users.UserActor(used.UserGuid)
+
+		return users.Audit(ctx, tx, models.UserAuditEntryActionVerifyEmail, actor, used.UserGuid, &before, &after, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("verifying email: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) ResetPassword(ctx context.Context, token, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	var userGUID uuid.UUID
+
+	err = s.repo.InTx(ctx, func(tx repo) error {
+		used, err := tx.UseEmailToken(ctx, query.UseEmailTokenParams{
+			TokenHash: hashToken(token),
+			Purpose:   purposeResetPassword,
+		})
+		if errors.Is(err, errNotFound) {
+			return ErrInvalidEmailToken
+		}
+
+		if err != nil {
+			return err
+		}
+
+		user, err := tx.GetUser(ctx, used.UserGuid)
+		if err != nil {
+			return err
+		}
+
+		if user.IsDeleted || !strings.EqualFold(user.Email.String, used.Email) {
+			return ErrInvalidEmailToken
+		}
+
+		updated, err := tx.UpdatePasswordHash(ctx, query.UpdatePasswordHashParams{
+			PasswordHash: string(hash),
+			UserGuid:     used.UserGuid,
+		})
+		if err != nil {
+			return err
+		}
+
+		if updated == 0 {
+			return ErrInvalidEmailToken
+		}
+
+		// пароль мог быть сброшен из-за утечки, поэтому завершаем все сессии
+		userGUID = used.UserGuid
+
+		if _, err = tx.RevokeUserSessions(ctx, used.UserGuid); err != nil {
+			return err
+		}
+
+		if _, err = tx.RevokeUserRefreshTokens(ctx, used.UserGuid); err != nil {
+			return err
+		}
+
+		// пароль не входит в состояние пользователя, поэтому состояния до и после не пишутся
+		actor := users.UserActor(used.UserGuid)
+
+		return users.Audit(ctx, tx, models.UserAuditEntryActionPasswordReset, actor, used.UserGuid, nil, nil, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("resetting password: %w", err)
+	}
+
+	s.sessions.forgetUser(userGUID)
+
+	return nil
+}
+
+func (s *service) ResendVerification(ctx context.Context, email string) error {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+
+	if user.IsDeleted || user.EmailVerifiedAt.Valid {
+		return nil
+	}
+
+	return s.requestEmailToken(ctx, user.Guid, purposeVerifyEmail, user.Email.String)
+}
+
+func (s *service) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+
+	// без логина пароль задать некуда, например у пользователей, созданных через /user
+	if user.IsDeleted || !user.HasCredentials {
+		return nil
+	}
+
+	return s.requestEmailToken(ctx, user.Guid, purposeResetPassword, user.Email.String)
+}
+
+func (s *service) requestEmailToken(ctx context.Context, userGUID uuid.UUID, purpose, email string) error {
+	var token string
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		var err error
+
+		token, err = s.issueEmailToken(ctx, tx, userGUID, purpose, email)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("issuing email token: %w", err)
+	}
+
+	s.sendEmailToken(ctx, purpose, email, token)
+
+	return nil
+}
+
+// issueEmailToken выдает новый токен, ранее выданные токены того же назначения перестают действовать.
+func (s *service) issueEmailToken(ctx context.Context, tx repo, userGUID uuid.UUID, purpose, email string) (string, error) {
+	err := tx.InvalidateEmailTokens(ctx, query.InvalidateEmailTokensParams{UserGuid: userGUID, Purpose: purpose})
+	if err != nil {
+		return "", err
+	}
+
+	token, tokenHash, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.InsertEmailToken(ctx, query.InsertEmailTokenParams{
+		TokenHash: tokenHash,
+		UserGuid:  userGUID,
+		Purpose:   purpose,
+		Email:     email,
+		ExpiresAt: time.Now().Add(s.emailLinks.ttl(purpose)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// sendEmailToken отправляет письмо после фиксации токена. Ошибка отправки только пишется в лог:
+// ответ не должен зависеть от наличия адреса, а письмо можно запросить повторно.
+func (s *service) sendEmailToken(ctx context.Context, purpose, email, token string) {
+	if err := s.mailer.Send(ctx, s.emailLinks.message(purpose, email, token)); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("purpose", purpose).Msg("send email token")
+	}
+}
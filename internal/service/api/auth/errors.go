@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid login or password")
+	ErrInvalidToken       = errors.New("invalid or expired token")
+	ErrLoginTaken         = errors.New("login already taken")
+	ErrEmailTaken         = errors.New("email already taken")
+	ErrInvalidEmailToken  = errors.New("invalid, expired or already used email token")
+	ErrValidation         = errors.New("invalid registration data")
+	ErrUnknownRole        = errors.New("unknown role")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrSessionNotFound    = errors.New("session not found or already ended")
+	ErrUnknownProvider    = errors.New("unknown oidc provider")
+	ErrOIDCLogin          = errors.New("oidc login failed")
+	ErrOIDCUnavailable    = errors.New("oidc provider unavailable")
+	ErrIdentityConflict   = errors.New("another account of this provider is already linked to the user")
+	ErrMFANotEnrolled     = errors.New("two-factor authentication is not enabled")
+	ErrMFAAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrInvalidMFACode     = errors.New("invalid two-factor code")
+	ErrInvalidAPIKey      = errors.New("invalid api key data")
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	// ErrMFALocked возвращается как *MFALockedError со сроком блокировки.
+	ErrMFALocked = errors.New("too many invalid two-factor codes")
+
+	errNotFound = errors.New("not found")
+)
+
+// Коды ошибок Postgres, см. https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgNotNullViolation    = "23502"
+	pgCheckViolation      = "23514"
+	pgStringTruncation    = "22001"
+)
+
+// usersEmailKey уникальный индекс email в users, остальные нарушения уникальности при регистрации - занятый логин.
+const usersEmailKey = "users_email_key"
+
+// Ограничения уникальности user_identities: внешний аккаунт уже привязан при параллельном входе
+// или к пользователю уже привязан другой аккаунт того же провайдера.
+const (
+	userIdentitiesPKey        = "user_identities_pkey"
+	userIdentitiesUserGUIDKey = "user_identities_user_guid_provider_key"
+)
+
+type sqlStateError interface {
+	SQLState() string
+}
+
+// storageError переводит ошибки драйвера в доменные ошибки сервиса.
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return errNotFound
+	}
+
+	var pgErr sqlStateError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.SQLState() {
+	case pgUniqueViolation:
+		var pgxErr pgx.PgError
+		if errors.As(err, &pgxErr) {
+			switch pgxErr.ConstraintName {
+			case usersEmailKey:
+				return fmt.Errorf("%w: %w", ErrEmailTaken, err)
+			case userIdentitiesPKey, userIdentitiesUserGUIDKey:
+				return fmt.Errorf("%w: %w", ErrIdentityConflict, err)
+			}
+		}
+
+		return fmt.Errorf("%w: %w", ErrLoginTaken, err)
+	case pgForeignKeyViolation:
+		return fmt.Errorf("%w: %w", ErrUserNotFound, err)
+	case pgNotNullViolation, pgCheckViolation, pgStringTruncation:
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,535 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+)
+
+const (
+	// mfaIssuerSuffix отличает токен второго шага входа от access token, подписанного тем же ключом.
+	mfaIssuerSuffix = "#mfa"
+	// totpPeriod и totpSkew интервал кода и число соседних интервалов, допустимых из-за расхождения часов.
+	totpPeriod = 30
+	totpSkew   = 1
+	totpDigits = otp.DigitsSix
+	// qrSize сторона PNG с QR кодом в пикселях.
+	qrSize = 256
+	// recoveryCodes число кодов восстановления, recoveryCodeBytes их энтропия.
+	recoveryCodes     = 10
+	recoveryCodeBytes = 10
+	recoveryCodeGroup = 4
+)
+
+// base32NoPadding кодировка секретов TOTP и кодов восстановления.
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding) //nolint:gochecknoglobals
+
+// MFALockedError проверка кодов второго фактора заблокирована до Until после серии неверных кодов.
+type MFALockedError struct {
+	Until time.Time
+}
+
+func (e *MFALockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrMFALocked, e.Until.UTC().Format(time.RFC3339))
+}
+
+func (e *MFALockedError) Unwrap() error {
+	return ErrMFALocked
+}
+
+// codeCheck какой секрет и какие коды принимаются при проверке кода второго фактора.
+type codeCheck struct {
+	// pending проверяется еще не подтвержденный секрет, иначе только подтвержденный.
+	pending bool
+	// recovery вместо кода из приложения принимается код восстановления.
+	recovery bool
+}
+
+func (s *service) MFAStatus(ctx context.Context, userGUID uuid.UUID) (*models.MFAStatus, error) {
+	secret, err := s.repo.GetUserTOTP(ctx, userGUID)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return nil, fmt.Errorf("getting totp: %w", err)
+	}
+
+	enabled := err == nil && secret.ConfirmedAt.Valid
+
+	left, err := s.repo.CountRecoveryCodes(ctx, userGUID)
+	if err != nil {
+		return nil, fmt.Errorf("counting recovery codes: %w", err)
+	}
+
+	return &models.MFAStatus{
+		TotpEnabled:       enabled,
+		RecoveryCodesLeft: left,
+	}, nil
+}
+
+// EnrollTOTP выпускает новый секрет. Неподтвержденный секрет заменяется, подтвержденный - нет.
+func (s *service) EnrollTOTP(ctx context.Context, userGUID uuid.UUID) (*models.TOTPEnrollment, error) {
+	account, err := s.totpAccount(ctx, userGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{ //nolint:exhaustruct
+		Issuer:      s.mfa.issuer,
+		AccountName: account,
+		Period:      totpPeriod,
+		Digits:      totpDigits,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate totp secret: %w", err)
+	}
+
+	upserted, err := s.repo.UpsertUserTOTP(ctx, query.UpsertUserTOTPParams{UserGuid: userGUID, Secret: key.Secret()})
+	if err != nil {
+		return nil, fmt.Errorf("saving totp: %w", err)
+	}
+
+	if upserted == 0 {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	return &models.TOTPEnrollment{Secret: key.Secret(), OtpauthURI: key.URL()}, nil
+}
+
+// TOTPQRCode PNG с otpauth:// URI неподтвержденного секрета.
+func (s *service) TOTPQRCode(ctx context.Context, userGUID uuid.UUID) ([]byte, error) {
+	secret, err := s.repo.GetUserTOTP(ctx, userGUID)
+	if errors.Is(err, errNotFound) || err == nil && secret.ConfirmedAt.Valid {
+		return nil, ErrMFANotEnrolled
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting totp: %w", err)
+	}
+
+	account, err := s.totpAccount(ctx, userGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := base32NoPadding.DecodeString(secret.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decode totp secret: %w", err)
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{ //nolint:exhaustruct
+		Issuer:      s.mfa.issuer,
+		AccountName: account,
+		Period:      totpPeriod,
+		Secret:      raw,
+		Digits:      totpDigits,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("build totp key: %w", err)
+	}
+
+	img, err := key.Image(qrSize, qrSize)
+	if err != nil {
+		return nil, fmt.Errorf("render qr code: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ConfirmTOTP включает второй фактор и выдает коды восстановления взамен прежних.
+func (s *service) ConfirmTOTP(ctx context.Context, userGUID uuid.UUID, code string) (*models.RecoveryCodes, error) {
+	var res *models.RecoveryCodes
+
+	err := s.withCode(ctx, userGUID, code, codeCheck{pending: true, recovery: false}, func(tx repo) error {
+		if _, err := tx.ConfirmUserTOTP(ctx, userGUID); err != nil {
+			return err
+		}
+
+		var err error
+		res, err = replaceRecoveryCodes(ctx, tx, userGUID)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("confirming totp: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *service) RegenerateRecoveryCodes(ctx context.Context, userGUID uuid.UUID, code string) (*models.RecoveryCodes, error) {
+	var res *models.RecoveryCodes
+
+	err := s.withCode(ctx, userGUID, code, codeCheck{pending: false, recovery: false}, func(tx repo) error {
+		var err error
+		res, err = replaceRecoveryCodes(ctx, tx, userGUID)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("regenerating recovery codes: %w", err)
+	}
+
+	return res, nil
+}
+
+// DisableTOTP отключает второй фактор. Без requireCode код не проверяется, так отключает администратор.
+// Неподтвержденный секрет удаляется без кода: он еще не защищает вход.
+func (s *service) DisableTOTP(ctx context.Context, userGUID uuid.UUID, code string, requireCode bool) error {
+	disable := func(tx repo) error {
+		if err := tx.DeleteRecoveryCodes(ctx, userGUID); err != nil {
+			return err
+		}
+
+		deleted, err := tx.DeleteUserTOTP(ctx, userGUID)
+		if err != nil {
+			return err
+		}
+
+		if deleted == 0 {
+			return ErrMFANotEnrolled
+		}
+
+		return nil
+	}
+
+	secret, err := s.repo.GetUserTOTP(ctx, userGUID)
+	if errors.Is(err, errNotFound) {
+		return ErrMFANotEnrolled
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting totp: %w", err)
+	}
+
+	if !requireCode || !secret.ConfirmedAt.Valid {
+		err = s.repo.InTx(ctx, disable)
+	} else {
+		err = s.withCode(ctx, userGUID, code, codeCheck{pending: false, recovery: true}, disable)
+	}
+
+	if err != nil {
+		return fmt.Errorf("disabling totp: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyMFA второй шаг входа: проверяет код для токена из ответа Login и начинает сессию.
+func (s *service) VerifyMFA(ctx context.Context, mfaToken, code string, client Client) (*models.AuthTokens, error) {
+	userGUID, err := s.tokens.parseMFA(mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *models.AuthTokens
+
+	err = s.withCode(ctx, userGUID, code, codeCheck{pending: false, recovery: true}, func(tx repo) error {
+		user, err := tx.GetUser(ctx, userGUID)
+		if err != nil {
+			return err
+		}
+
+		// пользователь мог быть удален после ввода пароля
+		if user.IsDeleted {
+			return ErrInvalidCredentials
+		}
+
+		res, err = s.startSession(ctx, tx, userGUID, client)
+
+		return err
+	})
+	if errors.Is(err, ErrMFANotEnrolled) {
+		// второй фактор отключили после ввода пароля, токен второго шага больше не действует
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("verifying mfa: %w", err)
+	}
+
+	return res, nil
+}
+
+// mfaChallenge токен второго шага входа для пользователя с подтвержденным TOTP, nil без второго фактора.
+func (s *service) mfaChallenge(ctx context.Context, r repo, userGUID uuid.UUID) (*models.MFAChallenge, error) {
+	secret, err := r.GetUserTOTP(ctx, userGUID)
+	if errors.Is(err, errNotFound) || err == nil && !secret.ConfirmedAt.Valid {
+		return nil, nil //nolint:nilnil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting totp: %w", err)
+	}
+
+	signed, err := s.tokens.issueMFA(userGUID, time.Now().Add(s.mfa.challengeTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MFAChallenge{MfaToken: signed, ExpiresIn: int64(s.mfa.challengeTTL.Seconds())}, nil
+}
+
+// withCode проверяет код и при успехе выполняет then в той же транзакции. Неверный код
+// увеличивает счетчик попыток, поэтому транзакция с ним фиксируется, а ошибка возвращается после.
+func (s *service) withCode(ctx context.Context, userGUID uuid.UUID, code string, check codeCheck, then func(tx repo) error) error {
+	var codeErr error
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		secret, err := tx.GetUserTOTPForUpdate(ctx, userGUID)
+		if errors.Is(err, errNotFound) {
+			return ErrMFANotEnrolled
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case check.pending && secret.ConfirmedAt.Valid:
+			return ErrMFAAlreadyEnabled
+		case !check.pending && !secret.ConfirmedAt.Valid:
+			return ErrMFANotEnrolled
+		}
+
+		now := time.Now()
+
+		if secret.LockedUntil.Valid && now.Before(secret.LockedUntil.Time) {
+			return &MFALockedError{Until: secret.LockedUntil.Time}
+		}
+
+		ok, step, err := matchCode(ctx, tx, secret, code, check.recovery, now)
+		if err != nil {
+			return err
+		}
+
+		attempts := s.attempts(secret, ok, step, now)
+		if err = tx.UpdateTOTPAttempts(ctx, attempts); err != nil {
+			return err
+		}
+
+		if !ok {
+			codeErr = ErrInvalidMFACode
+			if attempts.LockedUntil.Valid {
+				codeErr = &MFALockedError{Until: attempts.LockedUntil.Time}
+			}
+
+			return nil
+		}
+
+		return then(tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	return codeErr
+}
+
+// matchCode проверяет код из приложения, а если разрешено - и код восстановления, который при совпадении
+// помечается использованным. Для кода из приложения возвращает его интервал.
+func matchCode(ctx context.Context, tx repo, secret query.UserTotp, code string, recovery bool, now time.Time) (bool, int64, error) {
+	ok, step, err := matchTOTP(secret.Secret, strings.TrimSpace(code), secret.LastUsedStep, now)
+	if err != nil || ok || !recovery {
+		return ok, step, err
+	}
+
+	used, err := tx.UseRecoveryCode(ctx, query.UseRecoveryCodeParams{
+		UserGuid: secret.UserGuid,
+		CodeHash: hashToken(normalizeRecoveryCode(code)),
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	return used > 0, secret.LastUsedStep, nil
+}
+
+// attempts состояние проверки кодов после попытки: верный код сбрасывает счетчик, а неверный код,
+// исчерпавший попытки, блокирует проверку на s.mfa.lockout.
+func (s *service) attempts(secret query.UserTotp, ok bool, step int64, now time.Time) query.UpdateTOTPAttemptsParams {
+	res := query.UpdateTOTPAttemptsParams{
+		LastUsedStep:   secret.LastUsedStep,
+		FailedAttempts: 0,
+		LockedUntil:    sql.NullTime{}, //nolint:exhaustruct
+		UserGuid:       secret.UserGuid,
+	}
+
+	switch {
+	case ok:
+		res.LastUsedStep = max(step, secret.LastUsedStep)
+	case int(secret.FailedAttempts)+1 >= s.mfa.maxAttempts:
+		res.LockedUntil = sql.NullTime{Time: now.Add(s.mfa.lockout), Valid: true}
+	default:
+		res.FailedAttempts = secret.FailedAttempts + 1
+	}
+
+	return res
+}
+
+// matchTOTP ищет интервал кода среди текущего и соседних. Интервал не позже уже принятого
+// не подходит, так один код нельзя использовать дважды.
+func matchTOTP(secret, code string, lastUsed int64, now time.Time) (bool, int64, error) {
+	if len(code) != totpDigits.Length() {
+		return false, 0, nil
+	}
+
+	current := now.Unix() / totpPeriod
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastUsed {
+			continue
+		}
+
+		want, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), totp.ValidateOpts{ //nolint:exhaustruct
+			Period:    totpPeriod,
+			Digits:    totpDigits,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return false, 0, fmt.Errorf("generate totp code: %w", err)
+		}
+
+		if subtle.ConstantTimeCompare([]byte(code), []byte(want)) == 1 {
+			return true, step, nil
+		}
+	}
+
+	return false, 0, nil
+}
+
+// replaceRecoveryCodes заменяет коды восстановления новыми. В базе хранятся только хэши.
+func replaceRecoveryCodes(ctx context.Context, tx repo, userGUID uuid.UUID) (*models.RecoveryCodes, error) {
+	if err := tx.DeleteRecoveryCodes(ctx, userGUID); err != nil {
+		return nil, err
+	}
+
+	res := &models.RecoveryCodes{Codes: make([]string, 0, recoveryCodes)}
+
+	for range recoveryCodes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.InsertRecoveryCode(ctx, query.InsertRecoveryCodeParams{
+			UserGuid: userGUID,
+			CodeHash: hashToken(normalizeRecoveryCode(code)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res.Codes = append(res.Codes, code)
+	}
+
+	return res, nil
+}
+
+// newRecoveryCode код вида abcd-efgh-ijkl-mnop.
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeBytes)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate recovery code: %w", err)
+	}
+
+	raw := strings.ToLower(base32NoPadding.EncodeToString(buf))
+	groups := make([]string, 0, len(raw)/recoveryCodeGroup)
+
+	for i := 0; i < len(raw); i += recoveryCodeGroup {
+		groups = append(groups, raw[i:min(i+recoveryCodeGroup, len(raw))])
+	}
+
+	return strings.Join(groups, "-"), nil
+}
+
+// normalizeRecoveryCode код восстановления принимается в любом регистре, с дефисами и пробелами или без.
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(code))
+}
+
+// totpAccount имя аккаунта в приложении-аутентификаторе: имя пользователя, email или GUID.
+func (s *service) totpAccount(ctx context.Context, userGUID uuid.UUID) (string, error) {
+	user, err := s.repo.GetUser(ctx, userGUID)
+	if errors.Is(err, errNotFound) || err == nil && user.IsDeleted {
+		return "", ErrUserNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("getting user: %w", err)
+	}
+
+	switch {
+	case user.Username.Valid:
+		return user.Username.String, nil
+	case user.Email.Valid:
+		return user.Email.String, nil
+	default:
+		return user.Guid.String(), nil
+	}
+}
+
+func (t *tokenIssuer) issueMFA(subject uuid.UUID, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{ //nolint:exhaustruct
+		Issuer:    t.issuer + mfaIssuerSuffix,
+		Subject:   subject.String(),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        uuid.NewString(),
+	})
+
+	signed, err := token.SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("sign mfa token: %w", err)
+	}
+
+	return signed, nil
+}
+
+func (t *tokenIssuer) parseMFA(raw string) (uuid.UUID, error) {
+	var c jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(raw, &c, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(t.issuer+mfaIssuerSuffix),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: mfa token: %w", ErrInvalidToken, err)
+	}
+
+	subject, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: mfa token subject: %w", ErrInvalidToken, err)
+	}
+
+	return subject, nil
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"golang.org/x/crypto/bcrypt"
+
+	query "otusgruz/internal/repo"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+// totpCode код интервала step.
+func totpCode(t *testing.T, secret string, step int64) string {
+	t.Helper()
+
+	code, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), totp.ValidateOpts{ //nolint:exhaustruct
+		Period:    totpPeriod,
+		Digits:    totpDigits,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		t.Fatalf("generate totp code: %v", err)
+	}
+
+	return code
+}
+
+// newMFAService сервис с пользователем, у которого подтвержден TOTP. Возвращает коды восстановления.
+func newMFAService(t *testing.T) (*service, uuid.UUID, []string) {
+	t.Helper()
+
+	r := newMemRepo()
+
+	s, err := NewService(r, nil, Config{ //nolint:exhaustruct
+		Secret:          "secret",
+		Issuer:          "otusgruz",
+		AccessTTL:       time.Minute,
+		RefreshTTL:      time.Hour,
+		BcryptCost:      bcrypt.MinCost,
+		MFAIssuer:       "Otusgruz",
+		MFAChallengeTTL: time.Minute,
+		MFAMaxAttempts:  5,
+		MFALockout:      time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	ctx := context.Background()
+	userGUID := uuid.New()
+
+	if _, err = r.InsertUser(ctx, query.InsertUserParams{Guid: userGUID, Name: "Alice"}); err != nil { //nolint:exhaustruct
+		t.Fatalf("insert user: %v", err)
+	}
+
+	enrollment, err := s.EnrollTOTP(ctx, userGUID)
+	if err != nil {
+		t.Fatalf("enroll totp: %v", err)
+	}
+
+	codes, err := s.ConfirmTOTP(ctx, userGUID, totpCode(t, enrollment.Secret, time.Now().Unix()/totpPeriod))
+	if err != nil {
+		t.Fatalf("confirm totp: %v", err)
+	}
+
+	return s.(*service), userGUID, codes.Codes
+}
+
+// mfaToken токен второго шага входа, как его выдает Login.
+func mfaToken(t *testing.T, s *service, userGUID uuid.UUID) string {
+	t.Helper()
+
+	token, err := s.tokens.issueMFA(userGUID, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("issue mfa token: %v", err)
+	}
+
+	return token
+}
+
+func TestMatchTOTPWindow(t *testing.T) {
+	now := time.Unix(1_757_000_000, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		code     string
+		lastUsed int64
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", code: totpCode(t, testTOTPSecret, current), wantOK: true, wantStep: current},
+		{name: "previous step", code: totpCode(t, testTOTPSecret, current-1), wantOK: true, wantStep: current - 1},
+		{name: "next step", code: totpCode(t, testTOTPSecret, current+1), wantOK: true, wantStep: current + 1},
+		{name: "outside window before", code: totpCode(t, testTOTPSecret, current-2)},
+		{name: "outside window after", code: totpCode(t, testTOTPSecret, current+2)},
+		{name: "used step", code: totpCode(t, testTOTPSecret, current), lastUsed: current},
+		{name: "step before used", code: totpCode(t, testTOTPSecret, current-1), lastUsed: current},
+		{
+			name:     "step after used",
+			code:     totpCode(t, testTOTPSecret, current+1),
+			lastUsed: current,
+			wantOK:   true,
+			wantStep: current + 1,
+		},
+		{name: "wrong length", code: totpCode(t, testTOTPSecret, current)[1:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, step, err := matchTOTP(testTOTPSecret, tt.code, tt.lastUsed, now)
+			if err != nil {
+				t.Fatalf("match totp: %v", err)
+			}
+
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("match %v at step %d, want %v at step %d", ok, step, tt.wantOK, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestVerifyMFARecoveryCodeOneTimeUse(t *testing.T) {
+	s, userGUID, codes := newMFAService(t)
+
+	// шаги выполняются по порядку: код восстановления, принятый однажды, повторно не принимается
+	steps := []struct {
+		name    string
+		code    string
+		wantErr error
+	}{
+		{name: "recovery code", code: codes[0]},
+		{name: "same recovery code again", code: codes[0], wantErr: ErrInvalidMFACode},
+		{name: "another code in other format", code: strings.ToUpper(strings.ReplaceAll(codes[1], "-", ""))},
+		{name: "unknown code", code: "aaaa-bbbb-cccc-dddd", wantErr: ErrInvalidMFACode},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			tokens, err := s.VerifyMFA(context.Background(), mfaToken(t, s, userGUID), step.code, Client{}) //nolint:exhaustruct
+			if !errors.Is(err, step.wantErr) {
+				t.Fatalf("verify mfa: %v, want %v", err, step.wantErr)
+			}
+
+			if (tokens != nil) != (step.wantErr == nil) {
+				t.Errorf("tokens %v with error %v", tokens, err)
+			}
+		})
+	}
+
+	status, err := s.MFAStatus(context.Background(), userGUID)
+	if err != nil {
+		t.Fatalf("mfa status: %v", err)
+	}
+
+	if status.RecoveryCodesLeft != recoveryCodes-2 {
+		t.Errorf("%d recovery codes left, want %d", status.RecoveryCodesLeft, recoveryCodes-2)
+	}
+}
+
+func TestMFATokenIsNotAccessToken(t *testing.T) {
+	s, userGUID, codes := newMFAService(t)
+	ctx := context.Background()
+
+	tokens, err := s.VerifyMFA(ctx, mfaToken(t, s, userGUID), codes[0], Client{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("verify mfa: %v", err)
+	}
+
+	edge := NewAuthenticator(Config{Secret: "secret", Issuer: "otusgruz", AccessTTL: time.Minute}) //nolint:exhaustruct
+
+	tests := []struct {
+		name string
+		use  func() error
+	}{
+		{
+			name: "mfa token as access token",
+			use: func() error {
+				_, err := s.Authenticate(ctx, mfaToken(t, s, userGUID))
+
+				return err
+			},
+		},
+		{
+			name: "mfa token in edge authentication",
+			use: func() error {
+				_, err := edge.Authenticate(ctx, mfaToken(t, s, userGUID))
+
+				return err
+			},
+		},
+		{
+			name: "access token as mfa token",
+			use: func() error {
+				_, err := s.VerifyMFA(ctx, tokens.AccessToken, codes[1], Client{}) //nolint:exhaustruct
+
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.use(); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("error %v, want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+
+	if _, err = s.Authenticate(ctx, tokens.AccessToken); err != nil {
+		t.Errorf("access token after mfa: %v", err)
+	}
+}
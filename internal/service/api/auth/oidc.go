@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/oidc"
+	query "otusgruz/internal/repo"
+	users "otusgruz/internal/service/api/user"
+)
+
+const (
+	// flowIssuerSuffix отличает токен попытки входа от access token, подписанного тем же ключом.
+	flowIssuerSuffix = "#oidc-flow"
+	// maxNameLen и maxEmailLen размеры колонок users.name и users.email.
+	maxNameLen  = 255
+	maxEmailLen = 255
+	defaultName = "user"
+)
+
+// OIDCProvider провайдер входа через SSO.
+type OIDCProvider struct {
+	Client *oidc.Provider
+	// LinkByEmail при первом входе привязывать внешний аккаунт к пользователю с тем же подтвержденным email.
+	LinkByEmail bool
+}
+
+// OIDCRedirect начало входа через провайдера: клиент переходит по URL,
+// а Flow хранит до возврата от провайдера и передает в OIDCCallback.
+type OIDCRedirect struct {
+	URL       string
+	Flow      string
+	ExpiresAt time.Time
+}
+
+// OIDCCallbackParams параметры возврата от провайдера и сохраненный клиентом Flow.
+type OIDCCallbackParams struct {
+	Flow  string
+	Code  string
+	State string
+	// Error код ошибки, с которым провайдер вернул пользователя, например access_denied.
+	Error string
+}
+
+type flowClaims struct {
+	jwt.RegisteredClaims
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func (s *service) OIDCStart(ctx context.Context, provider, loginHint string) (*OIDCRedirect, error) {
+	p, ok := s.oidc[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+
+	flow, err := oidc.NewFlow()
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, err := p.Client.AuthCodeURL(ctx, flow, loginHint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCUnavailable, err)
+	}
+
+	expiresAt := time.Now().Add(s.oidcStateTTL)
+
+	signed, err := s.tokens.issueFlow(provider, flow, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCRedirect{URL: redirect, Flow: signed, ExpiresAt: expiresAt}, nil
+}
+
+func (s *service) OIDCCallback(
+	ctx context.Context, provider string, params OIDCCallbackParams, client Client,
+) (*models.AuthTokens, *models.MFAChallenge, error) {
+	p, ok := s.oidc[provider]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+
+	flow, err := s.tokens.parseFlow(params.Flow, provider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrOIDCLogin, err)
+	}
+
+	// state привязывает возврат к браузеру, который начал вход
+	if subtle.ConstantTimeCompare([]byte(params.State), []byte(flow.State)) != 1 {
+		return nil, nil, fmt.Errorf("%w: state mismatch", ErrOIDCLogin)
+	}
+
+	if params.Error != "" {
+		return nil, nil, fmt.Errorf("%w: provider returned %s", ErrOIDCLogin, params.Error)
+	}
+
+	identity, err := p.Client.Exchange(ctx, params.Code, flow)
+	if errors.Is(err, oidc.ErrUnavailable) {
+		return nil, nil, fmt.Errorf("%w: %w", ErrOIDCUnavailable, err)
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrOIDCLogin, err)
+	}
+
+	var (
+		res       *models.AuthTokens
+		challenge *models.MFAChallenge
+	)
+
+	err = s.repo.InTx(ctx, func(tx repo) error {
+		userGUID, err := s.identityUser(ctx, tx, provider, p, identity)
+		if err != nil {
+			return err
+		}
+
+		// вход через провайдер заменяет только пароль, второй фактор проверяется так же, как в Login;
+		// привязка аккаунта фиксируется, а сессия начнется в VerifyMFA
+		if challenge, err = s.mfaChallenge(ctx, tx, userGUID); err != nil || challenge != nil {
+			return err
+		}
+
+		res, err = s.startSession(ctx, tx, userGUID, client)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("oidc login: %w", err)
+	}
+
+	return res, challenge, nil
+}
+
+// identityUser пользователь, привязанный к внешнему аккаунту. При первом входе аккаунт привязывается
+// к пользователю с тем же email (если это разрешено для провайдера) или к новому пользователю.
+func (s *service) identityUser(
+	ctx context.Context, tx repo, provider string, p OIDCProvider, identity *oidc.Identity,
+) (uuid.UUID, error) {
+	email := sql.NullString{String: identity.Email, Valid: identity.Email != "" && len(identity.Email) <= maxEmailLen}
+
+	linked, err := tx.GetUserIdentity(ctx, query.GetUserIdentityParams{Provider: provider, Subject: identity.Subject})
+	if err == nil {
+		if linked.IsDeleted {
+			return uuid.Nil, ErrInvalidCredentials
+		}
+
+		err = tx.TouchUserIdentity(ctx, query.TouchUserIdentityParams{
+			Email:    email,
+			Provider: provider,
+			Subject:  identity.Subject,
+		})
+
+		return linked.UserGuid, err
+	}
+
+	if !errors.Is(err, errNotFound) {
+		return uuid.Nil, err
+	}
+
+	// непроверенному провайдером адресу верить нельзя ни для привязки, ни для нового пользователя
+	verified := email
+	if !identity.EmailVerified {
+		verified.Valid = false
+	}
+
+	userGUID, err := s.identityOwner(ctx, tx, p, identity, verified)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = tx.InsertUserIdentity(ctx, query.InsertUserIdentityParams{
+		Provider: provider,
+		Subject:  identity.Subject,
+		UserGuid: userGUID,
+		Email:    email,
+	})
+
+	return userGUID, err
+}
+
+// identityOwner находит пользователя для привязки по email или создает нового с ролью user и без пароля.
+func (s *service) identityOwner(
+	ctx context.Context, tx repo, p OIDCProvider, identity *oidc.Identity, email sql.NullString,
+) (uuid.UUID, error) {
+	if email.Valid {
+		existing, err := tx.GetUserByEmail(ctx, email.String)
+
+		switch {
+		case err == nil:
+			// адрес должен быть подтвержден и у нас, иначе заранее зарегистрированный на чужой адрес
+			// аккаунт получил бы доступ к входу владельца адреса
+			if p.LinkByEmail && !existing.IsDeleted && existing.EmailVerifiedAt.Valid {
+				return existing.Guid, nil
+			}
+
+			// адрес занят другим пользователем, новый создается без него
+			email.Valid = false
+		case !errors.Is(err, errNotFound):
+			return uuid.Nil, err
+		}
+	}
+
+	created, err := tx.InsertUser(ctx, query.InsertUserParams{ //nolint:exhaustruct
+		Guid:  uuid.New(),
+		Name:  identityName(identity),
+		Email: email,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if email.Valid {
+		_, err = tx.MarkEmailVerified(ctx, query.MarkEmailVerifiedParams{Guid: created.Guid, Email: email.String})
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	err = tx.InsertUserRole(ctx, query.InsertUserRoleParams{UserGuid: created.Guid, Role: RoleUser})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// в событии - пользователь с уже подтвержденным email
+	if created, err = tx.GetUser(ctx, created.Guid); err != nil {
+		return uuid.Nil, err
+	}
+
+	err = users.Audit(ctx, tx, models.UserAuditEntryActionCreate, users.UserActor(created.Guid), created.Guid, nil, &created, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return created.Guid, users.Enqueue(ctx, tx, models.UserAuditEntryActionCreate, created)
+}
+
+// identityName имя нового пользователя из ID token.
+func identityName(identity *oidc.Identity) string {
+	name := identity.Name
+	if name == "" {
+		name = identity.PreferredUsername
+	}
+
+	if name == "" {
+		return defaultName
+	}
+
+	if utf8.RuneCountInString(name) > maxNameLen {
+		return string([]rune(name)[:maxNameLen])
+	}
+
+	return name
+}
+
+func (t *tokenIssuer) issueFlow(provider string, flow oidc.Flow, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, flowClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    t.issuer + flowIssuerSuffix,
+			Audience:  jwt.ClaimStrings{provider},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		State:    flow.State,
+		Nonce:    flow.Nonce,
+		Verifier: flow.Verifier,
+	})
+
+	signed, err := token.SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("sign oidc flow: %w", err)
+	}
+
+	return signed, nil
+}
+
+// parseFlow принимает только попытку входа через тот же провайдер.
+func (t *tokenIssuer) parseFlow(raw, provider string) (oidc.Flow, error) {
+	var c flowClaims
+
+	_, err := jwt.ParseWithClaims(raw, &c, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(t.issuer+flowIssuerSuffix),
+		jwt.WithAudience(provider),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return oidc.Flow{}, fmt.Errorf("oidc flow: %w", err)
+	}
+
+	return oidc.Flow{State: c.State, Nonce: c.Nonce, Verifier: c.Verifier}, nil
+}
@@ -0,0 +1,261 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"otusgruz/internal/oidc"
+	"otusgruz/internal/oidc/oidctest"
+	query "otusgruz/internal/repo"
+)
+
+const (
+	testProvider    = "corp"
+	testRedirectURL = "https://app.example.com/api/auth/oidc/corp/callback"
+)
+
+func newOIDCService(t *testing.T, linkByEmail bool) (*service, *memRepo, *oidctest.Provider) {
+	t.Helper()
+
+	idp, err := oidctest.NewServer()
+	if err != nil {
+		t.Fatalf("start provider: %v", err)
+	}
+
+	t.Cleanup(idp.Close)
+
+	client := oidc.NewProvider(http.DefaultClient, oidc.Config{ //nolint:exhaustruct
+		Issuer:       idp.Issuer(),
+		ClientID:     "otusgruz",
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		JWKSCacheTTL: time.Hour,
+	})
+
+	r := newMemRepo()
+
+	s, err := NewService(r, nil, Config{ //nolint:exhaustruct
+		Secret:          "secret",
+		Issuer:          "otusgruz",
+		AccessTTL:       time.Minute,
+		RefreshTTL:      time.Hour,
+		BcryptCost:      bcrypt.MinCost,
+		OIDC:            map[string]OIDCProvider{testProvider: {Client: client, LinkByEmail: linkByEmail}},
+		OIDCStateTTL:    time.Minute,
+		MFAChallengeTTL: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	return s.(*service), r, idp
+}
+
+// authorize начинает вход и проходит страницу провайдера, как браузер.
+// Возвращает параметры возврата на callback вместе с сохраненным клиентом Flow.
+func authorize(t *testing.T, s *service, loginHint string) OIDCCallbackParams {
+	t.Helper()
+
+	redirect, err := s.OIDCStart(context.Background(), testProvider, loginHint)
+	if err != nil {
+		t.Fatalf("oidc start: %v", err)
+	}
+
+	browser := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { //nolint:exhaustruct
+		return http.ErrUseLastResponse
+	}}
+
+	resp, err := browser.Get(redirect.URL) //nolint:noctx
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	defer resp.Body.Close()
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d, location %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+
+	return OIDCCallbackParams{
+		Flow:  redirect.Flow,
+		Code:  location.Query().Get("code"),
+		State: location.Query().Get("state"),
+		Error: location.Query().Get("error"),
+	}
+}
+
+func oidcLogin(t *testing.T, s *service, loginHint string) uuid.UUID {
+	t.Helper()
+
+	tokens, challenge, err := s.OIDCCallback(context.Background(), testProvider, authorize(t, s, loginHint), Client{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("oidc callback: %v", err)
+	}
+
+	if challenge != nil || tokens == nil {
+		t.Fatalf("tokens %v, challenge %v, want tokens only", tokens, challenge)
+	}
+
+	return uuid.MustParse(tokens.UserGUID.String())
+}
+
+func TestOIDCLoginCreatesUser(t *testing.T) {
+	s, r, idp := newOIDCService(t, true)
+	idp.SetUser("alice", oidctest.User{Subject: "alice-sub", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+
+	userGUID := oidcLogin(t, s, "alice")
+
+	user := r.users[userGUID]
+	if user.Name != "Alice" || user.Email.String != "alice@example.com" || !user.EmailVerifiedAt.Valid {
+		t.Errorf("created user %+v, want Alice with verified alice@example.com", user)
+	}
+
+	if roles := r.roles[userGUID]; len(roles) != 1 || roles[0] != RoleUser {
+		t.Errorf("roles %v, want [%s]", roles, RoleUser)
+	}
+
+	if len(r.sessions) != 1 {
+		t.Errorf("%d sessions, want 1", len(r.sessions))
+	}
+
+	if event := createdEvent(t, r, userGUID); event.User.EmailVerifiedAt == nil {
+		t.Errorf("user.created without verified email: %+v", event.User)
+	}
+
+	createdAudit(t, r, userGUID)
+
+	if again := oidcLogin(t, s, "alice"); again != userGUID {
+		t.Errorf("second login as %s, want %s", again, userGUID)
+	}
+
+	if len(r.users) != 1 || len(r.events) != 1 || len(r.audit) != 1 {
+		t.Errorf("%d users, %d events and %d audit records after second login, want 1", len(r.users), len(r.events), len(r.audit))
+	}
+}
+
+func TestOIDCCallbackRejectsForeignFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(t *testing.T, s *service, params *OIDCCallbackParams)
+	}{
+		{
+			name: "state mismatch",
+			edit: func(_ *testing.T, _ *service, params *OIDCCallbackParams) { params.State = "forged" },
+		},
+		{
+			name: "flow of another attempt",
+			edit: func(t *testing.T, s *service, params *OIDCCallbackParams) {
+				t.Helper()
+
+				params.Flow = authorize(t, s, "").Flow
+			},
+		},
+		{
+			name: "pkce verifier mismatch",
+			edit: func(t *testing.T, s *service, params *OIDCCallbackParams) {
+				t.Helper()
+
+				// state и Flow другой попытки согласованы, но код выдан под чужой code_challenge
+				other := authorize(t, s, "")
+				params.Flow, params.State = other.Flow, other.State
+			},
+		},
+		{
+			name: "provider error",
+			edit: func(_ *testing.T, _ *service, params *OIDCCallbackParams) {
+				params.Code, params.Error = "", "access_denied"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, r, _ := newOIDCService(t, true)
+			params := authorize(t, s, "")
+
+			tt.edit(t, s, &params)
+
+			_, _, err := s.OIDCCallback(context.Background(), testProvider, params, Client{}) //nolint:exhaustruct
+			if !errors.Is(err, ErrOIDCLogin) {
+				t.Errorf("error %v, want %v", err, ErrOIDCLogin)
+			}
+
+			if len(r.users) != 0 || len(r.sessions) != 0 {
+				t.Errorf("%d users and %d sessions after rejected callback", len(r.users), len(r.sessions))
+			}
+		})
+	}
+}
+
+func TestOIDCLinkByEmail(t *testing.T) {
+	tests := []struct {
+		name          string
+		linkByEmail   bool
+		localVerified bool
+		idpVerified   bool
+		wantLinked    bool
+	}{
+		{name: "verified on both sides", linkByEmail: true, localVerified: true, idpVerified: true, wantLinked: true},
+		{name: "linking disabled", linkByEmail: false, localVerified: true, idpVerified: true, wantLinked: false},
+		{name: "local email not verified", linkByEmail: true, localVerified: false, idpVerified: true, wantLinked: false},
+		{name: "provider email not verified", linkByEmail: true, localVerified: true, idpVerified: false, wantLinked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, r, idp := newOIDCService(t, tt.linkByEmail)
+			idp.SetUser("bob", oidctest.User{Subject: "bob-sub", Email: "bob@example.com", EmailVerified: tt.idpVerified, Name: "Bob"})
+
+			local := uuid.New()
+			r.users[local] = query.User{ //nolint:exhaustruct
+				Guid:            local,
+				Name:            "bob",
+				Email:           sql.NullString{String: "bob@example.com", Valid: true},
+				EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: tt.localVerified},
+			}
+
+			userGUID := oidcLogin(t, s, "bob")
+
+			if linked := userGUID == local; linked != tt.wantLinked {
+				t.Fatalf("linked to local user %v, want %v", linked, tt.wantLinked)
+			}
+
+			// адрес занят локальным пользователем, новый создается без него
+			if !tt.wantLinked && r.users[userGUID].Email.Valid {
+				t.Errorf("new user got email %q of another user", r.users[userGUID].Email.String)
+			}
+		})
+	}
+}
+
+func TestOIDCCallbackRequiresMFA(t *testing.T) {
+	s, r, _ := newOIDCService(t, true)
+	userGUID := oidcLogin(t, s, "carol")
+
+	r.totp[userGUID] = query.UserTotp{UserGuid: userGUID, ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}} //nolint:exhaustruct
+
+	tokens, challenge, err := s.OIDCCallback(context.Background(), testProvider, authorize(t, s, "carol"), Client{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("oidc callback: %v", err)
+	}
+
+	if tokens != nil || challenge == nil || challenge.MfaToken == "" {
+		t.Fatalf("tokens %v, challenge %v, want challenge only", tokens, challenge)
+	}
+
+	if subject, err := s.tokens.parseMFA(challenge.MfaToken); err != nil || subject != userGUID {
+		t.Errorf("mfa token for %s (%v), want %s", subject, err, userGUID)
+	}
+
+	if len(r.sessions) != 1 {
+		t.Errorf("%d sessions, want only the one before TOTP was enabled", len(r.sessions))
+	}
+}
@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// Repo обертка над запросами sqlc, возвращающая доменные ошибки сервиса.
+type Repo struct {
+	db *sql.DB
+	tx *sql.Tx
+	q  *query.Queries
+}
+
+func NewRepo(db *sql.DB, q *query.Queries) *Repo {
+	return &Repo{db: db, q: q} //nolint:exhaustruct
+}
+
+// InTx выполняет fn в транзакции. Вложенный вызов переиспользует уже открытую транзакцию.
+func (r *Repo) InTx(ctx context.Context, fn func(tx repo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	if err = fn(&Repo{db: r.db, tx: tx, q: r.q.WithTx(tx)}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return storageError(fmt.Errorf("commit transaction: %w", err))
+	}
+
+	return nil
+}
+
+func (r *Repo) GetUser(ctx context.Context, guid uuid.UUID) (query.User, error) {
+	res, err := r.q.GetUser(ctx, guid)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertUser(ctx context.Context, arg query.InsertUserParams) (query.User, error) {
+	res, err := r.q.InsertUser(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertCredentials(ctx context.Context, arg query.InsertCredentialsParams) error {
+	return storageError(r.q.InsertCredentials(ctx, arg))
+}
+
+func (r *Repo) GetCredentialsByLogin(ctx context.Context, login string) (query.GetCredentialsByLoginRow, error) {
+	res, err := r.q.GetCredentialsByLogin(ctx, login)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertRefreshToken(ctx context.Context, arg query.InsertRefreshTokenParams) error {
+	return storageError(r.q.InsertRefreshToken(ctx, arg))
+}
+
+func (r *Repo) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (query.RefreshToken, error) {
+	res, err := r.q.GetRefreshTokenForUpdate(ctx, tokenHash)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeRefreshToken(ctx context.Context, tokenHash string) (int64, error) {
+	res, err := r.q.RevokeRefreshToken(ctx, tokenHash)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) (int64, error) {
+	res, err := r.q.RevokeRefreshTokenFamily(ctx, familyID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetUserRoles(ctx context.Context, userGUID uuid.UUID) ([]string, error) {
+	res, err := r.q.GetUserRoles(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertUserRole(ctx context.Context, arg query.InsertUserRoleParams) error {
+	return storageError(r.q.InsertUserRole(ctx, arg))
+}
+
+func (r *Repo) DeleteUserRole(ctx context.Context, arg query.DeleteUserRoleParams) (int64, error) {
+	res, err := r.q.DeleteUserRole(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetUserByEmail(ctx context.Context, email string) (query.GetUserByEmailRow, error) {
+	res, err := r.q.GetUserByEmail(ctx, email)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) UpdatePasswordHash(ctx context.Context, arg query.UpdatePasswordHashParams) (int64, error) {
+	res, err := r.q.UpdatePasswordHash(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeUserRefreshTokens(ctx context.Context, userGUID uuid.UUID) (int64, error) {
+	res, err := r.q.RevokeUserRefreshTokens(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertEmailToken(ctx context.Context, arg query.InsertEmailTokenParams) error {
+	return storageError(r.q.InsertEmailToken(ctx, arg))
+}
+
+func (r *Repo) InvalidateEmailTokens(ctx context.Context, arg query.InvalidateEmailTokensParams) error {
+	return storageError(r.q.InvalidateEmailTokens(ctx, arg))
+}
+
+func (r *Repo) UseEmailToken(ctx context.Context, arg query.UseEmailTokenParams) (query.EmailToken, error) {
+	res, err := r.q.UseEmailToken(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) MarkEmailVerified(ctx context.Context, arg query.MarkEmailVerifiedParams) (int64, error) {
+	res, err := r.q.MarkEmailVerified(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertSession(ctx context.Context, arg query.InsertSessionParams) error {
+	return storageError(r.q.InsertSession(ctx, arg))
+}
+
+func (r *Repo) TouchSession(ctx context.Context, arg query.TouchSessionParams) (int64, error) {
+	res, err := r.q.TouchSession(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ListUserSessions(ctx context.Context, userGUID uuid.UUID) ([]query.Session, error) {
+	res, err := r.q.ListUserSessions(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeSession(ctx context.Context, arg query.RevokeSessionParams) (int64, error) {
+	res, err := r.q.RevokeSession(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeUserSessions(ctx context.Context, userGUID uuid.UUID) (int64, error) {
+	res, err := r.q.RevokeUserSessions(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) SessionActive(ctx context.Context, arg query.SessionActiveParams) (bool, error) {
+	res, err := r.q.SessionActive(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetUserIdentity(ctx context.Context, arg query.GetUserIdentityParams) (query.GetUserIdentityRow, error) {
+	res, err := r.q.GetUserIdentity(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertUserIdentity(ctx context.Context, arg query.InsertUserIdentityParams) error {
+	return storageError(r.q.InsertUserIdentity(ctx, arg))
+}
+
+func (r *Repo) TouchUserIdentity(ctx context.Context, arg query.TouchUserIdentityParams) error {
+	return storageError(r.q.TouchUserIdentity(ctx, arg))
+}
+
+func (r *Repo) GetUserTOTP(ctx context.Context, userGUID uuid.UUID) (query.UserTotp, error) {
+	res, err := r.q.GetUserTOTP(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetUserTOTPForUpdate(ctx context.Context, userGUID uuid.UUID) (query.UserTotp, error) {
+	res, err := r.q.GetUserTOTPForUpdate(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) UpsertUserTOTP(ctx context.Context, arg query.UpsertUserTOTPParams) (int64, error) {
+	res, err := r.q.UpsertUserTOTP(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ConfirmUserTOTP(ctx context.Context, userGUID uuid.UUID) (int64, error) {
+	res, err := r.q.ConfirmUserTOTP(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) UpdateTOTPAttempts(ctx context.Context, arg query.UpdateTOTPAttemptsParams) error {
+	return storageError(r.q.UpdateTOTPAttempts(ctx, arg))
+}
+
+func (r *Repo) DeleteUserTOTP(ctx context.Context, userGUID uuid.UUID) (int64, error) {
+	res, err := r.q.DeleteUserTOTP(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertRecoveryCode(ctx context.Context, arg query.InsertRecoveryCodeParams) error {
+	return storageError(r.q.InsertRecoveryCode(ctx, arg))
+}
+
+func (r *Repo) UseRecoveryCode(ctx context.Context, arg query.UseRecoveryCodeParams) (int64, error) {
+	res, err := r.q.UseRecoveryCode(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) CountRecoveryCodes(ctx context.Context, userGUID uuid.UUID) (int64, error) {
+	res, err := r.q.CountRecoveryCodes(ctx, userGUID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) DeleteRecoveryCodes(ctx context.Context, userGUID uuid.UUID) error {
+	return storageError(r.q.DeleteRecoveryCodes(ctx, userGUID))
+}
+
+func (r *Repo) InsertAPIKey(ctx context.Context, arg query.InsertAPIKeyParams) (query.ApiKey, error) {
+	res, err := r.q.InsertAPIKey(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetAPIKey(ctx context.Context, id uuid.UUID) (query.ApiKey, error) {
+	res, err := r.q.GetAPIKey(ctx, id)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (query.ApiKey, error) {
+	res, err := r.q.GetAPIKeyByPrefix(ctx, prefix)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ListAPIKeys(ctx context.Context) ([]query.ApiKey, error) {
+	res, err := r.q.ListAPIKeys(ctx)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) RevokeAPIKey(ctx context.Context, id uuid.UUID) (int64, error) {
+	res, err := r.q.RevokeAPIKey(ctx, id)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	return storageError(r.q.TouchAPIKey(ctx, id))
+}
+
+func (r *Repo) InsertOutboxEvent(ctx context.Context, arg query.InsertOutboxEventParams) error {
+	return storageError(r.q.InsertOutboxEvent(ctx, arg))
+}
+
+func (r *Repo) EnqueueWebhookDeliveries(ctx context.Context, arg query.EnqueueWebhookDeliveriesParams) error {
+	return storageError(r.q.EnqueueWebhookDeliveries(ctx, arg))
+}
+
+func (r *Repo) InsertUserAudit(ctx context.Context, arg query.InsertUserAuditParams) error {
+	return storageError(r.q.InsertUserAudit(ctx, arg))
+}
@@ -0,0 +1,311 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// memRepo хранилище в памяти для тестов сервиса. Реализует только запросы, которые нужны тестам,
+// вызов остальных паникует на встроенном nil repo.
+type memRepo struct {
+	repo
+
+	mu         sync.Mutex
+	users      map[uuid.UUID]query.User
+	roles      map[uuid.UUID][]string
+	identities map[query.GetUserIdentityParams]uuid.UUID
+	totp       map[uuid.UUID]query.UserTotp
+	// recovery коды восстановления, значение - признак использования
+	recovery   map[query.InsertRecoveryCodeParams]bool
+	sessions   map[uuid.UUID]query.InsertSessionParams
+	refresh    map[string]query.InsertRefreshTokenParams
+	creds      map[string]query.InsertCredentialsParams
+	events     []query.InsertOutboxEventParams
+	deliveries []query.EnqueueWebhookDeliveriesParams
+	audit      []query.InsertUserAuditParams
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{ //nolint:exhaustruct
+		users:      make(map[uuid.UUID]query.User),
+		roles:      make(map[uuid.UUID][]string),
+		identities: make(map[query.GetUserIdentityParams]uuid.UUID),
+		totp:       make(map[uuid.UUID]query.UserTotp),
+		recovery:   make(map[query.InsertRecoveryCodeParams]bool),
+		sessions:   make(map[uuid.UUID]query.InsertSessionParams),
+		refresh:    make(map[string]query.InsertRefreshTokenParams),
+		creds:      make(map[string]query.InsertCredentialsParams),
+	}
+}
+
+// InTx выполняет fn под общей блокировкой, откат транзакции не поддерживается.
+func (m *memRepo) InTx(_ context.Context, fn func(tx repo) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return fn(m)
+}
+
+func (m *memRepo) InsertUser(_ context.Context, arg query.InsertUserParams) (query.User, error) {
+	user := query.User{ //nolint:exhaustruct
+		Guid:       arg.Guid,
+		Name:       arg.Name,
+		Occupation: arg.Occupation,
+		Email:      arg.Email,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Version:    1,
+	}
+	m.users[arg.Guid] = user
+
+	return user, nil
+}
+
+func (m *memRepo) GetUser(_ context.Context, guid uuid.UUID) (query.User, error) {
+	user, ok := m.users[guid]
+	if !ok {
+		return user, errNotFound
+	}
+
+	return user, nil
+}
+
+func (m *memRepo) InsertCredentials(_ context.Context, arg query.InsertCredentialsParams) error {
+	m.creds[arg.Login] = arg
+
+	return nil
+}
+
+func (m *memRepo) GetUserByEmail(_ context.Context, email string) (query.GetUserByEmailRow, error) {
+	for _, user := range m.users {
+		if user.Email.Valid && strings.EqualFold(user.Email.String, email) {
+			return query.GetUserByEmailRow{ //nolint:exhaustruct
+				Guid:            user.Guid,
+				Email:           user.Email,
+				EmailVerifiedAt: user.EmailVerifiedAt,
+				IsDeleted:       user.IsDeleted,
+			}, nil
+		}
+	}
+
+	return query.GetUserByEmailRow{}, errNotFound //nolint:exhaustruct
+}
+
+func (m *memRepo) MarkEmailVerified(_ context.Context, arg query.MarkEmailVerifiedParams) (int64, error) {
+	user, ok := m.users[arg.Guid]
+	if !ok || user.Email.String != arg.Email {
+		return 0, nil
+	}
+
+	user.EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	m.users[arg.Guid] = user
+
+	return 1, nil
+}
+
+func (m *memRepo) GetUserRoles(_ context.Context, userGUID uuid.UUID) ([]string, error) {
+	return m.roles[userGUID], nil
+}
+
+func (m *memRepo) InsertUserRole(_ context.Context, arg query.InsertUserRoleParams) error {
+	m.roles[arg.UserGuid] = append(m.roles[arg.UserGuid], arg.Role)
+
+	return nil
+}
+
+func (m *memRepo) DeleteUserRole(_ context.Context, arg query.DeleteUserRoleParams) (int64, error) {
+	roles := m.roles[arg.UserGuid]
+	if i := slices.Index(roles, arg.Role); i >= 0 {
+		m.roles[arg.UserGuid] = slices.Delete(roles, i, i+1)
+
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (m *memRepo) GetUserIdentity(_ context.Context, arg query.GetUserIdentityParams) (query.GetUserIdentityRow, error) {
+	userGUID, ok := m.identities[arg]
+	if !ok {
+		return query.GetUserIdentityRow{}, errNotFound //nolint:exhaustruct
+	}
+
+	return query.GetUserIdentityRow{UserGuid: userGUID, IsDeleted: m.users[userGUID].IsDeleted}, nil
+}
+
+func (m *memRepo) InsertUserIdentity(_ context.Context, arg query.InsertUserIdentityParams) error {
+	m.identities[query.GetUserIdentityParams{Provider: arg.Provider, Subject: arg.Subject}] = arg.UserGuid
+
+	return nil
+}
+
+func (m *memRepo) TouchUserIdentity(context.Context, query.TouchUserIdentityParams) error {
+	return nil
+}
+
+func (m *memRepo) GetUserTOTP(_ context.Context, userGUID uuid.UUID) (query.UserTotp, error) {
+	secret, ok := m.totp[userGUID]
+	if !ok {
+		return secret, errNotFound
+	}
+
+	return secret, nil
+}
+
+func (m *memRepo) GetUserTOTPForUpdate(ctx context.Context, userGUID uuid.UUID) (query.UserTotp, error) {
+	return m.GetUserTOTP(ctx, userGUID)
+}
+
+// UpsertUserTOTP как и запрос, не заменяет подтвержденный секрет.
+func (m *memRepo) UpsertUserTOTP(_ context.Context, arg query.UpsertUserTOTPParams) (int64, error) {
+	if secret, ok := m.totp[arg.UserGuid]; ok && secret.ConfirmedAt.Valid {
+		return 0, nil
+	}
+
+	m.totp[arg.UserGuid] = query.UserTotp{UserGuid: arg.UserGuid, Secret: arg.Secret, CreatedAt: time.Now()} //nolint:exhaustruct
+
+	return 1, nil
+}
+
+func (m *memRepo) ConfirmUserTOTP(_ context.Context, userGUID uuid.UUID) (int64, error) {
+	secret, ok := m.totp[userGUID]
+	if !ok || secret.ConfirmedAt.Valid {
+		return 0, nil
+	}
+
+	secret.ConfirmedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	m.totp[userGUID] = secret
+
+	return 1, nil
+}
+
+func (m *memRepo) UpdateTOTPAttempts(_ context.Context, arg query.UpdateTOTPAttemptsParams) error {
+	secret := m.totp[arg.UserGuid]
+	secret.LastUsedStep = arg.LastUsedStep
+	secret.FailedAttempts = arg.FailedAttempts
+	secret.LockedUntil = arg.LockedUntil
+	m.totp[arg.UserGuid] = secret
+
+	return nil
+}
+
+func (m *memRepo) InsertRecoveryCode(_ context.Context, arg query.InsertRecoveryCodeParams) error {
+	m.recovery[arg] = false
+
+	return nil
+}
+
+func (m *memRepo) UseRecoveryCode(_ context.Context, arg query.UseRecoveryCodeParams) (int64, error) {
+	code := query.InsertRecoveryCodeParams(arg)
+	if used, ok := m.recovery[code]; !ok || used {
+		return 0, nil
+	}
+
+	m.recovery[code] = true
+
+	return 1, nil
+}
+
+func (m *memRepo) CountRecoveryCodes(_ context.Context, userGUID uuid.UUID) (int64, error) {
+	left := int64(0)
+
+	for code, used := range m.recovery {
+		if code.UserGuid == userGUID && !used {
+			left++
+		}
+	}
+
+	return left, nil
+}
+
+func (m *memRepo) DeleteRecoveryCodes(_ context.Context, userGUID uuid.UUID) error {
+	maps.DeleteFunc(m.recovery, func(code query.InsertRecoveryCodeParams, _ bool) bool {
+		return code.UserGuid == userGUID
+	})
+
+	return nil
+}
+
+func (m *memRepo) InsertSession(_ context.Context, arg query.InsertSessionParams) error {
+	m.sessions[arg.ID] = arg
+
+	return nil
+}
+
+// RevokeSession удаляет сессию: завершенные сессии тестам не нужны.
+func (m *memRepo) RevokeSession(_ context.Context, arg query.RevokeSessionParams) (int64, error) {
+	session, ok := m.sessions[arg.ID]
+	if !ok || session.UserGuid != arg.UserGuid {
+		return 0, nil
+	}
+
+	delete(m.sessions, arg.ID)
+
+	return 1, nil
+}
+
+func (m *memRepo) SessionActive(_ context.Context, arg query.SessionActiveParams) (bool, error) {
+	session, ok := m.sessions[arg.ID]
+
+	return ok && session.UserGuid == arg.UserGuid && session.ExpiresAt.After(time.Now()), nil
+}
+
+func (m *memRepo) GetRefreshTokenForUpdate(_ context.Context, tokenHash string) (query.RefreshToken, error) {
+	token, ok := m.refresh[tokenHash]
+	if !ok {
+		return query.RefreshToken{}, errNotFound //nolint:exhaustruct
+	}
+
+	return query.RefreshToken{ //nolint:exhaustruct
+		TokenHash: token.TokenHash,
+		FamilyID:  token.FamilyID,
+		UserGuid:  token.UserGuid,
+		ExpiresAt: token.ExpiresAt,
+	}, nil
+}
+
+func (m *memRepo) RevokeRefreshTokenFamily(_ context.Context, familyID uuid.UUID) (int64, error) {
+	revoked := int64(0)
+
+	for hash, token := range m.refresh {
+		if token.FamilyID == familyID {
+			delete(m.refresh, hash)
+			revoked++
+		}
+	}
+
+	return revoked, nil
+}
+
+func (m *memRepo) InsertRefreshToken(_ context.Context, arg query.InsertRefreshTokenParams) error {
+	m.refresh[arg.TokenHash] = arg
+
+	return nil
+}
+
+func (m *memRepo) InsertOutboxEvent(_ context.Context, arg query.InsertOutboxEventParams) error {
+	m.events = append(m.events, arg)
+
+	return nil
+}
+
+func (m *memRepo) EnqueueWebhookDeliveries(_ context.Context, arg query.EnqueueWebhookDeliveriesParams) error {
+	m.deliveries = append(m.deliveries, arg)
+
+	return nil
+}
+
+func (m *memRepo) InsertUserAudit(_ context.Context, arg query.InsertUserAuditParams) error {
+	m.audit = append(m.audit, arg)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package auth
+
+const (
+	// RoleUser управляет только собственными данными.
+	RoleUser = "user"
+	// RoleAdmin управляет любыми пользователями.
+	RoleAdmin = "admin"
+	// RoleService роль межсервисных клиентов.
+	RoleService = "service"
+)
+
+func isKnownRole(role string) bool {
+	switch role {
+	case RoleUser, RoleAdmin, RoleService:
+		return true
+	default:
+		return false
+	}
+}
+
+// Права ключей API межсервисных клиентов.
+const (
+	// ScopeUsersRead чтение пользователей и истории их изменений.
+	ScopeUsersRead = "users:read"
+	// ScopeUsersWrite создание, изменение и удаление пользователей.
+	ScopeUsersWrite = "users:write"
+	// ScopeAdmin дает ключу роль admin.
+	ScopeAdmin = "admin"
+)
+
+func isKnownScope(scope string) bool {
+	switch scope {
+	case ScopeUsersRead, ScopeUsersWrite, ScopeAdmin:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,538 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"otusgruz/internal/mailer"
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+	users "otusgruz/internal/service/api/user"
+)
+
+const tokenType = "Bearer"
+
+type repo interface {
+	GetUser(ctx context.Context, guid uuid.UUID) (query.User, error)
+	InsertUser(ctx context.Context, arg query.InsertUserParams) (query.User, error)
+	InsertCredentials(ctx context.Context, arg query.InsertCredentialsParams) error
+	GetCredentialsByLogin(ctx context.Context, login string) (query.GetCredentialsByLoginRow, error)
+	InsertRefreshToken(ctx context.Context, arg query.InsertRefreshTokenParams) error
+	GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (query.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, tokenHash string) (int64, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	GetUserRoles(ctx context.Context, userGUID uuid.UUID) ([]string, error)
+	InsertUserRole(ctx context.Context, arg query.InsertUserRoleParams) error
+	DeleteUserRole(ctx context.Context, arg query.DeleteUserRoleParams) (int64, error)
+	GetUserByEmail(ctx context.Context, email string) (query.GetUserByEmailRow, error)
+	UpdatePasswordHash(ctx context.Context, arg query.UpdatePasswordHashParams) (int64, error)
+	RevokeUserRefreshTokens(ctx context.Context, userGUID uuid.UUID) (int64, error)
+	InsertEmailToken(ctx context.Context, arg query.InsertEmailTokenParams) error
+	InvalidateEmailTokens(ctx context.Context, arg query.InvalidateEmailTokensParams) error
+	UseEmailToken(ctx context.Context, arg query.UseEmailTokenParams) (query.EmailToken, error)
+	MarkEmailVerified(ctx context.Context, arg query.MarkEmailVerifiedParams) (int64, error)
+	InsertSession(ctx context.Context, arg query.InsertSessionParams) error
+	TouchSession(ctx context.Context, arg query.TouchSessionParams) (int64, error)
+	ListUserSessions(ctx context.Context, userGUID uuid.UUID) ([]query.Session, error)
+	RevokeSession(ctx context.Context, arg query.RevokeSessionParams) (int64, error)
+	RevokeUserSessions(ctx context.Context, userGUID uuid.UUID) (int64, error)
+	SessionActive(ctx context.Context, arg query.SessionActiveParams) (bool, error)
+	GetUserIdentity(ctx context.Context, arg query.GetUserIdentityParams) (query.GetUserIdentityRow, error)
+	InsertUserIdentity(ctx context.Context, arg query.InsertUserIdentityParams) error
+	TouchUserIdentity(ctx context.Context, arg query.TouchUserIdentityParams) error
+	GetUserTOTP(ctx context.Context, userGUID uuid.UUID) (query.UserTotp, error)
+	GetUserTOTPForUpdate(ctx context.Context, userGUID uuid.UUID) (query.UserTotp, error)
+	UpsertUserTOTP(ctx context.Context, arg query.UpsertUserTOTPParams) (int64, error)
+	ConfirmUserTOTP(ctx context.Context, userGUID uuid.UUID) (int64, error)
+	UpdateTOTPAttempts(ctx context.Context, arg query.UpdateTOTPAttemptsParams) error
+	DeleteUserTOTP(ctx context.Context, userGUID uuid.UUID) (int64, error)
+	InsertRecoveryCode(ctx context.Context, arg query.InsertRecoveryCodeParams) error
+	UseRecoveryCode(ctx context.Context, arg query.UseRecoveryCodeParams) (int64, error)
+	CountRecoveryCodes(ctx context.Context, userGUID uuid.UUID) (int64, error)
+	DeleteRecoveryCodes(ctx context.Context, userGUID uuid.UUID) error
+	InsertAPIKey(ctx context.Context, arg query.InsertAPIKeyParams) (query.ApiKey, error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (query.ApiKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (query.ApiKey, error)
+	ListAPIKeys(ctx context.Context) ([]query.ApiKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (int64, error)
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	users.EventRepo
+	users.AuditRepo
+	InTx(ctx context.Context, fn func(tx repo) error) error
+}
+
+type Service interface {
+	// Register при переданном email отправляет письмо для его подтверждения.
+	// Register и Login начинают новую сессию для устройства client.
+	Register(ctx context.Context, params *models.RegisterParams, client Client) (*models.AuthTokens, error)
+	// Login пользователю с подтвержденным TOTP вместо токенов возвращает MFAChallenge,
+	// сессия начинается после проверки кода в VerifyMFA.
+	Login(ctx context.Context, params *models.LoginParams, client Client) (*models.AuthTokens, *models.MFAChallenge, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string, client Client) (*models.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string, client Client) (*models.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Authenticator
+	GrantRole(ctx context.Context, userGUID uuid.UUID, role string) error
+	RevokeRole(ctx context.Context, userGUID uuid.UUID, role string) error
+	// VerifyEmail и ResetPassword принимают токен из письма, токен действует один раз.
+	VerifyEmail(ctx context.Context, token string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	// ResendVerification и ForgotPassword не сообщают, найден ли адрес.
+	ResendVerification(ctx context.Context, email string) error
+	ForgotPassword(ctx context.Context, email string) error
+	// ListSessions отмечает сессию current как текущую.
+	ListSessions(ctx context.Context, userGUID, current uuid.UUID) (*models.SessionList, error)
+	RevokeSession(ctx context.Context, userGUID, sessionID uuid.UUID) error
+	// OIDCStart и OIDCCallback вход через внешний провайдер. Первый вход привязывает внешний аккаунт
+	// к пользователю, новому или, если разрешено, с тем же подтвержденным email. Как и Login, пользователю
+	// с подтвержденным TOTP OIDCCallback вместо токенов возвращает MFAChallenge.
+	OIDCStart(ctx context.Context, provider, loginHint string) (*OIDCRedirect, error)
+	OIDCCallback(
+		ctx context.Context, provider string, params OIDCCallbackParams, client Client,
+	) (*models.AuthTokens, *models.MFAChallenge, error)
+	// EnrollTOTP выпускает секрет, который включает второй фактор после проверки кода в ConfirmTOTP.
+	// Неверные коды при подтверждении, отключении и входе считаются вместе, после MFAMaxAttempts подряд
+	// проверка блокируется с ошибкой *MFALockedError.
+	MFAStatus(ctx context.Context, userGUID uuid.UUID) (*models.MFAStatus, error)
+	EnrollTOTP(ctx context.Context, userGUID uuid.UUID) (*models.TOTPEnrollment, error)
+	TOTPQRCode(ctx context.Context, userGUID uuid.UUID) ([]byte, error)
+	ConfirmTOTP(ctx context.Context, userGUID uuid.UUID, code string) (*models.RecoveryCodes, error)
+	RegenerateRecoveryCodes(ctx context.Context, userGUID uuid.UUID, code string) (*models.RecoveryCodes, error)
+	DisableTOTP(ctx context.Context, userGUID uuid.UUID, code string, requireCode bool) error
+	// CreateAPIKey возвращает ключ целиком только в ответе на выпуск, в базе хранится его хэш.
+	// createdBy субъект, выпустивший ключ.
+	CreateAPIKey(ctx context.Context, createdBy uuid.UUID, params *models.APIKeyParams) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) (*models.APIKeyList, error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	APIKeyAuthenticator
+}
+
+type Config struct {
+	Secret     string
+	Issuer     string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	BcryptCost int
+
+	// SessionCheckTTL срок, в течение которого переиспользуется проверка сессии access token.
+	SessionCheckTTL time.Duration
+
+	VerifyEmailTTL   time.Duration
+	PasswordResetTTL time.Duration
+	// LinkBaseURL адрес фронтенда для ссылок в письмах.
+	LinkBaseURL string
+
+	// OIDC провайдеры входа через SSO по имени, OIDCStateTTL срок на возврат от провайдера.
+	OIDC         map[string]OIDCProvider
+	OIDCStateTTL time.Duration
+
+	// MFAIssuer название сервиса в приложении-аутентификаторе, MFAChallengeTTL срок на ввод кода после пароля.
+	MFAIssuer       string
+	MFAChallengeTTL time.Duration
+	// MFAMaxAttempts неверных кодов подряд, после которых проверка кодов блокируется на MFALockout.
+	MFAMaxAttempts int
+	MFALockout     time.Duration
+}
+
+type mfaConfig struct {
+	issuer       string
+	challengeTTL time.Duration
+	maxAttempts  int
+	lockout      time.Duration
+}
+
+type service struct {
+	repo       repo
+	mailer     mailer.Mailer
+	tokens     tokenIssuer
+	sessions   *sessionCache
+	refreshTTL time.Duration
+	bcryptCost int
+	emailLinks emailLinks
+	oidc       map[string]OIDCProvider
+	// oidcStateTTL срок действия попытки входа через провайдера
+	oidcStateTTL time.Duration
+	mfa          mfaConfig
+	// dummyHash сравнивается при неизвестном логине, чтобы время ответа не выдавало существование логина
+	dummyHash []byte
+}
+
+func NewService(repo repo, mail mailer.Mailer, conf Config) (Service, error) {
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), conf.BcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("generate dummy hash: %w", err)
+	}
+
+	return &service{
+		repo:   repo,
+		mailer: mail,
+		tokens: tokenIssuer{
+			secret:    []byte(conf.Secret),
+			issuer:    conf.Issuer,
+			accessTTL: conf.AccessTTL,
+		},
+		sessions:   newSessionCache(conf.SessionCheckTTL),
+		refreshTTL: conf.RefreshTTL,
+		bcryptCost: conf.BcryptCost,
+		emailLinks: emailLinks{
+			baseURL:   strings.TrimSuffix(conf.LinkBaseURL, "/"),
+			verifyTTL: conf.VerifyEmailTTL,
+			resetTTL:  conf.PasswordResetTTL,
+		},
+		oidc:         conf.OIDC,
+		oidcStateTTL: conf.OIDCStateTTL,
+		mfa: mfaConfig{
+			issuer:       conf.MFAIssuer,
+			challengeTTL: conf.MFAChallengeTTL,
+			maxAttempts:  conf.MFAMaxAttempts,
+			lockout:      conf.MFALockout,
+		},
+		dummyHash: dummyHash,
+	}, nil
+}
+
+func (s *service) Register(ctx context.Context, params *models.RegisterParams, client Client) (*models.AuthTokens, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), s.bcryptCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return nil, fmt.Errorf("%w: %w", ErrValidation, err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	var (
+		res          *models.AuthTokens
+		verifyToken  string
+		emailAddress = string(params.Email)
+	)
+
+	err = s.repo.InTx(ctx, func(tx repo) error {
+		created, err := tx.InsertUser(ctx, query.InsertUserParams{ //nolint:exhaustruct
+			Guid:       uuid.New(),
+			Name:       params.Name,
+			Occupation: params.Occupation,
+			Email:      sql.NullString{String: emailAddress, Valid: emailAddress != ""},
+		})
+		if err != nil {
+			return err
+		}
+
+		if emailAddress != "" {
+			verifyToken, err = s.issueEmailToken(ctx, tx, created.Guid, purposeVerifyEmail, emailAddress)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.InsertCredentials(ctx, query.InsertCredentialsParams{
+			UserGuid:     created.Guid,
+			Login:        normalizeLogin(params.Login),
+			PasswordHash: string(hash),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = tx.InsertUserRole(ctx, query.InsertUserRoleParams{UserGuid: created.Guid, Role: RoleUser})
+		if err != nil {
+			return err
+		}
+
+		err = users.Audit(ctx, tx, models.UserAuditEntryActionCreate, users.UserActor(created.Guid), created.Guid, nil, &created, nil)
+		if err != nil {
+			return err
+		}
+
+		if err = users.Enqueue(ctx, tx, models.UserAuditEntryActionCreate, created); err != nil {
+			return err
+		}
+
+		res, err = s.startSession(ctx, tx, created.Guid, client)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("registering user: %w", err)
+	}
+
+	if verifyToken != "" {
+		s.sendEmailToken(ctx, purposeVerifyEmail, emailAddress, verifyToken)
+	}
+
+	return res, nil
+}
+
+func (s *service) Login(
+	ctx context.Context, params *models.LoginParams, client Client,
+) (*models.AuthTokens, *models.MFAChallenge, error) {
+	creds, err := s.repo.GetCredentialsByLogin(ctx, normalizeLogin(params.Login))
+	if errors.Is(err, errNotFound) {
+		_ = bcrypt.CompareHashAndPassword(s.dummyHash, []byte(params.Password))
+
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting credentials: %w", err)
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(creds.PasswordHash), []byte(params.Password)); err != nil {
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	if creds.IsDeleted {
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	challenge, err := s.mfaChallenge(ctx, s.repo, creds.UserGuid)
+	if err != nil || challenge != nil {
+		return nil, challenge, err
+	}
+
+	var res *models.AuthTokens
+
+	err = s.repo.InTx(ctx, func(tx repo) error {
+		res, err = s.startSession(ctx, tx, creds.UserGuid, client)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("issuing tokens: %w", err)
+	}
+
+	return res, nil, nil
+}
+
+// errRefreshReuse откатывает транзакцию, если предъявлен уже использованный refresh token.
+var errRefreshReuse = errors.New("refresh token reuse")
+
+func (s *service) Refresh(ctx context.Context, refreshToken string, client Client) (*models.AuthTokens, error) {
+	var (
+		res    *models.AuthTokens
+		reused query.RefreshToken
+	)
+
+	tokenHash := hashToken(refreshToken)
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		token, err := tx.GetRefreshTokenForUpdate(ctx, tokenHash)
+		if errors.Is(err, errNotFound) {
+			return ErrInvalidToken
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if token.RevokedAt.Valid {
+			reused = token
+
+			return errRefreshReuse
+		}
+
+		if time.Now().After(token.ExpiresAt) {
+			return ErrInvalidToken
+		}
+
+		user, err := tx.GetUser(ctx, token.UserGuid)
+		if err != nil {
+			return err
+		}
+
+		if user.IsDeleted {
+			return ErrInvalidToken
+		}
+
+		if _, err = tx.RevokeRefreshToken(ctx, tokenHash); err != nil {
+			return err
+		}
+
+		if err = s.touchSession(ctx, tx, token.FamilyID, client); err != nil {
+			return err
+		}
+
+		res, err = s.issue(ctx, tx, token.UserGuid, token.FamilyID)
+
+		return err
+	})
+	if errors.Is(err, errRefreshReuse) {
+		// токен мог быть украден, поэтому завершаем всю сессию
+		err = s.repo.InTx(ctx, func(tx repo) error {
+			_, err := endSession(ctx, tx, reused.UserGuid, reused.FamilyID)
+
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("revoking token family: %w", err)
+		}
+
+		s.sessions.forget(reused.FamilyID)
+
+		return nil, ErrInvalidToken
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("refreshing tokens: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *service) Logout(ctx context.Context, refreshToken string) error {
+	var session uuid.UUID
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		token, err := tx.GetRefreshTokenForUpdate(ctx, hashToken(refreshToken))
+		if err != nil {
+			return err
+		}
+
+		session = token.FamilyID
+		_, err = endSession(ctx, tx, token.UserGuid, token.FamilyID)
+
+		return err
+	})
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("logging out: %w", err)
+	}
+
+	s.sessions.forget(session)
+
+	return nil
+}
+
+// Authenticate кроме подписи и срока токена проверяет, что его сессия не завершена.
+func (s *service) Authenticate(ctx context.Context, accessToken string) (*Principal, error) {
+	principal, err := s.tokens.Authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// токены, выданные до появления сессий, не содержат sid
+	if principal.SessionID == uuid.Nil {
+		return principal, nil
+	}
+
+	active, err := s.sessionActive(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("checking session: %w", err)
+	}
+
+	if !active {
+		return nil, fmt.Errorf("%w: session ended", ErrInvalidToken)
+	}
+
+	return principal, nil
+}
+
+// GrantRole новые роли попадают в access token при следующем входе или обновлении токенов.
+// Роли меняются командой roles, поэтому в журнал изменений пишется системная операция.
+func (s *service) GrantRole(ctx context.Context, userGUID uuid.UUID, role string) error {
+	if !isKnownRole(role) {
+		return fmt.Errorf("%w: %s", ErrUnknownRole, role)
+	}
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		roles, err := tx.GetUserRoles(ctx, userGUID)
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(roles, role) {
+			return nil
+		}
+
+		if err = tx.InsertUserRole(ctx, query.InsertUserRoleParams{UserGuid: userGUID, Role: role}); err != nil {
+			return err
+		}
+
+		return auditRole(ctx, tx, models.UserAuditEntryActionRoleGrant, userGUID, role)
+	})
+	if err != nil {
+		return fmt.Errorf("granting role: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) RevokeRole(ctx context.Context, userGUID uuid.UUID, role string) error {
+	if !isKnownRole(role) {
+		return fmt.Errorf("%w: %s", ErrUnknownRole, role)
+	}
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		deleted, err := tx.DeleteUserRole(ctx, query.DeleteUserRoleParams{UserGuid: userGUID, Role: role})
+		if err != nil || deleted == 0 {
+			return err
+		}
+
+		return auditRole(ctx, tx, models.UserAuditEntryActionRoleRevoke, userGUID, role)
+	})
+	if err != nil {
+		return fmt.Errorf("revoking role: %w", err)
+	}
+
+	return nil
+}
+
+// auditRole пишет в журнал смену роли. Роли не входят в состояние пользователя, поэтому роль передается в details.
+func auditRole(ctx context.Context, tx repo, action string, userGUID uuid.UUID, role string) error {
+	return users.Audit(ctx, tx, action, users.Actor{}, userGUID, nil, nil, map[string]string{"role": role}) //nolint:exhaustruct
+}
+
+// issue выдает пару токенов в сессии session, refresh token продолжает ее цепочку ротаций.
+func (s *service) issue(ctx context.Context, r repo, subject, session uuid.UUID) (*models.AuthTokens, error) {
+	now := time.Now()
+
+	roles, err := r.GetUserRoles(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := s.tokens.issueAccess(subject, session, roles, now)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, refreshHash, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.InsertRefreshToken(ctx, query.InsertRefreshTokenParams{
+		TokenHash: refreshHash,
+		FamilyID:  session,
+		UserGuid:  subject,
+		ExpiresAt: now.Add(s.refreshTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuthTokens{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    tokenType,
+		ExpiresIn:    int64(s.tokens.accessTTL.Seconds()),
+		UserGUID:     strfmt.UUID(subject.String()),
+	}, nil
+}
+
+func normalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/outbox"
+)
+
+// createdEvent единственное событие user.created о пользователе guid, поставленное в outbox и подписчикам.
+func createdEvent(t *testing.T, r *memRepo, guid uuid.UUID) outbox.UserEvent {
+	t.Helper()
+
+	if len(r.events) != 1 || len(r.deliveries) != 1 {
+		t.Fatalf("%d outbox events and %d webhook enqueues, want 1", len(r.events), len(r.deliveries))
+	}
+
+	event := r.events[0]
+	if event.EventType != outbox.TypeUserCreated || event.AggregateID != guid {
+		t.Errorf("event %s for %s, want %s for %s", event.EventType, event.AggregateID, outbox.TypeUserCreated, guid)
+	}
+
+	if r.deliveries[0].EventID != event.EventID {
+		t.Errorf("webhook deliveries for event %s, want %s", r.deliveries[0].EventID, event.EventID)
+	}
+
+	var payload outbox.UserEvent
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("decode event payload: %v", err)
+	}
+
+	if payload.User == nil || payload.User.GUID.String() != guid.String() {
+		t.Errorf("event payload user %+v, want %s", payload.User, guid)
+	}
+
+	return payload
+}
+
+// createdAudit проверяет, что создание пользователя guid записано в журнал от его имени.
+func createdAudit(t *testing.T, r *memRepo, guid uuid.UUID) {
+	t.Helper()
+
+	if len(r.audit) != 1 {
+		t.Fatalf("%d audit records, want 1", len(r.audit))
+	}
+
+	entry := r.audit[0]
+	if entry.Action != models.UserAuditEntryActionCreate || entry.UserGuid != guid || entry.Before.Valid || !entry.After.Valid {
+		t.Errorf("audit %s of %s (before %v, after %v), want create of %s with state after only",
+			entry.Action, entry.UserGuid, entry.Before.Valid, entry.After.Valid, guid)
+	}
+
+	if entry.Actor.UUID != guid || entry.ActorType.String != models.UserAuditEntryActorTypeUser {
+		t.Errorf("audit actor %s %s, want user %s", entry.ActorType.String, entry.Actor.UUID, guid)
+	}
+}
+
+func TestRegisterEnqueuesUserCreated(t *testing.T) {
+	r := newMemRepo()
+
+	s, err := NewService(r, nil, Config{ //nolint:exhaustruct
+		Secret:     "secret",
+		Issuer:     "otusgruz",
+		AccessTTL:  time.Minute,
+		RefreshTTL: time.Hour,
+		BcryptCost: bcrypt.MinCost,
+	})
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	tokens, err := s.Register(context.Background(), &models.RegisterParams{ //nolint:exhaustruct
+		Login:      "alice",
+		Password:   "correct horse battery staple",
+		Name:       "Alice",
+		Occupation: "engineer",
+	}, Client{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	userGUID := uuid.MustParse(tokens.UserGUID.String())
+
+	event := createdEvent(t, r, userGUID)
+	if event.User.Name != "Alice" || event.User.Occupation != "engineer" {
+		t.Errorf("event user %+v, want Alice, engineer", event.User)
+	}
+
+	createdAudit(t, r, userGUID)
+}
+
+func TestRoleChangesAreAudited(t *testing.T) {
+	r := newMemRepo()
+
+	s, err := NewService(r, nil, Config{Secret: "secret", BcryptCost: bcrypt.MinCost}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	ctx := context.Background()
+	userGUID := uuid.New()
+
+	// повторная выдача и отзыв отсутствующей роли ничего не меняют и в журнал не попадают
+	steps := []func() error{
+		func() error { return s.GrantRole(ctx, userGUID, RoleAdmin) },
+		func() error { return s.GrantRole(ctx, userGUID, RoleAdmin) },
+		func() error { return s.RevokeRole(ctx, userGUID, RoleAdmin) },
+		func() error { return s.RevokeRole(ctx, userGUID, RoleAdmin) },
+	}
+
+	for i, step := range steps {
+		if err = step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	want := []string{models.UserAuditEntryActionRoleGrant, models.UserAuditEntryActionRoleRevoke}
+	if len(r.audit) != len(want) {
+		t.Fatalf("%d audit records, want %d", len(r.audit), len(want))
+	}
+
+	for i, entry := range r.audit {
+		if entry.Action != want[i] || entry.UserGuid != userGUID || entry.Actor.Valid || entry.ActorType.Valid {
+			t.Errorf("audit %d: %s of %s by %v, want system %s of %s", i, entry.Action, entry.UserGuid, entry.Actor, want[i], userGUID)
+		}
+
+		if string(entry.Details.RawMessage) != `{"role":"admin"}` {
+			t.Errorf("audit %d details %s, want the admin role", i, entry.Details.RawMessage)
+		}
+	}
+}
+
+func TestAuthenticateRejectsEndedSession(t *testing.T) {
+	tests := []struct {
+		name string
+		end  func(ctx context.Context, s Service, tokens *models.AuthTokens, principal *Principal) error
+	}{
+		{
+			name: "logout",
+			end: func(ctx context.Context, s Service, tokens *models.AuthTokens, _ *Principal) error {
+				return s.Logout(ctx, tokens.RefreshToken)
+			},
+		},
+		{
+			name: "revoke session",
+			end: func(ctx context.Context, s Service, _ *models.AuthTokens, principal *Principal) error {
+				return s.RevokeSession(ctx, principal.Subject, principal.SessionID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// проверка сессии кэшируется дольше теста, поэтому отказ означает, что завершение сбросило кэш
+			s, err := NewService(newMemRepo(), nil, Config{ //nolint:exhaustruct
+				Secret:          "secret",
+				Issuer:          "otusgruz",
+				AccessTTL:       time.Minute,
+				RefreshTTL:      time.Hour,
+				BcryptCost:      bcrypt.MinCost,
+				SessionCheckTTL: time.Hour,
+			})
+			if err != nil {
+				t.Fatalf("new service: %v", err)
+			}
+
+			ctx := context.Background()
+
+			tokens, err := s.Register(ctx, &models.RegisterParams{ //nolint:exhaustruct
+				Login:      "alice",
+				Password:   "correct horse battery staple",
+				Name:       "Alice",
+				Occupation: "engineer",
+			}, Client{}) //nolint:exhaustruct
+			if err != nil {
+				t.Fatalf("register: %v", err)
+			}
+
+			principal, err := s.Authenticate(ctx, tokens.AccessToken)
+			if err != nil {
+				t.Fatalf("authenticate in active session: %v", err)
+			}
+
+			if err = tt.end(ctx, s, tokens, principal); err != nil {
+				t.Fatalf("end session: %v", err)
+			}
+
+			if _, err = s.Authenticate(ctx, tokens.AccessToken); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("authenticate in ended session: %v, want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+}
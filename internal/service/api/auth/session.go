@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+)
+
+const (
+	// maxUserAgentLen размер колонки sessions.user_agent.
+	maxUserAgentLen = 512
+	// maxCachedSessions записей в кэше проверки сессий, при превышении из него удаляются устаревшие.
+	maxCachedSessions = 10000
+)
+
+// Client сведения об устройстве, с которого выполнен вход или обновление токенов.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+func (c Client) userAgent() string {
+	ua := strings.ToValidUTF8(c.UserAgent, "")
+	if runes := []rune(ua); len(runes) > maxUserAgentLen {
+		return string(runes[:maxUserAgentLen])
+	}
+
+	return ua
+}
+
+// startSession создает сессию нового входа и выдает в ней первую пару токенов.
+func (s *service) startSession(ctx context.Context, r repo, subject uuid.UUID, client Client) (*models.AuthTokens, error) {
+	session := uuid.New()
+
+	err := r.InsertSession(ctx, query.InsertSessionParams{
+		ID:        session,
+		UserGuid:  subject,
+		UserAgent: client.userAgent(),
+		Ip:        client.IP,
+		ExpiresAt: time.Now().Add(s.refreshTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issue(ctx, r, subject, session)
+}
+
+// touchSession продлевает сессию при обновлении токенов. Завершенную сессию продлить нельзя.
+func (s *service) touchSession(ctx context.Context, r repo, session uuid.UUID, client Client) error {
+	touched, err := r.TouchSession(ctx, query.TouchSessionParams{
+		Ip:        client.IP,
+		ExpiresAt: time.Now().Add(s.refreshTTL),
+		ID:        session,
+	})
+	if err != nil {
+		return err
+	}
+
+	if touched == 0 {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// endSession завершает сессию и отзывает все ее refresh token. Возвращает число завершенных сессий.
+func endSession(ctx context.Context, r repo, userGUID, session uuid.UUID) (int64, error) {
+	ended, err := r.RevokeSession(ctx, query.RevokeSessionParams{ID: session, UserGuid: userGUID})
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = r.RevokeRefreshTokenFamily(ctx, session); err != nil {
+		return 0, err
+	}
+
+	return ended, nil
+}
+
+// sessionActive проверяет, что сессия access token не завершена и не истекла.
+func (s *service) sessionActive(ctx context.Context, principal *Principal) (bool, error) {
+	now := time.Now()
+
+	if active, ok := s.sessions.get(principal.SessionID, now); ok {
+		return active, nil
+	}
+
+	active, err := s.repo.SessionActive(ctx, query.SessionActiveParams{ID: principal.SessionID, UserGuid: principal.Subject})
+	if err != nil {
+		return false, err
+	}
+
+	s.sessions.put(principal.SessionID, principal.Subject, active, now)
+
+	return active, nil
+}
+
+// sessionCache результаты проверки сессий в течение ttl, при нулевом ttl не кэширует. Завершение сессии
+// в этом экземпляре сервиса сбрасывает ее запись сразу, в остальных оно становится видно через ttl.
+type sessionCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]sessionEntry
+}
+
+type sessionEntry struct {
+	user      uuid.UUID
+	active    bool
+	expiresAt time.Time
+}
+
+func newSessionCache(ttl time.Duration) *sessionCache {
+	return &sessionCache{ttl: ttl, entries: make(map[uuid.UUID]sessionEntry)} //nolint:exhaustruct
+}
+
+func (c *sessionCache) get(session uuid.UUID, now time.Time) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[session]
+	if !ok || !now.Before(entry.expiresAt) {
+		return false, false
+	}
+
+	return entry.active, true
+}
+
+func (c *sessionCache) put(session, user uuid.UUID, active bool, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxCachedSessions {
+		maps.DeleteFunc(c.entries, func(_ uuid.UUID, entry sessionEntry) bool {
+			return !now.Before(entry.expiresAt)
+		})
+	}
+
+	if len(c.entries) >= maxCachedSessions {
+		clear(c.entries)
+	}
+
+	c.entries[session] = sessionEntry{user: user, active: active, expiresAt: now.Add(c.ttl)}
+}
+
+// forget сбрасывает запись сессии. Вызывается после фиксации транзакции, завершившей сессию,
+// иначе параллельная проверка могла бы снова закэшировать ее действующей.
+func (c *sessionCache) forget(session uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, session)
+}
+
+// forgetUser сбрасывает записи всех сессий пользователя.
+func (c *sessionCache) forgetUser(user uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.DeleteFunc(c.entries, func(_ uuid.UUID, entry sessionEntry) bool {
+		return entry.user == user
+	})
+}
+
+func (s *service) ListSessions(ctx context.Context, userGUID, current uuid.UUID) (*models.SessionList, error) {
+	sessions, err := s.repo.ListUserSessions(ctx, userGUID)
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	res := &models.SessionList{Items: make([]*models.Session, 0, len(sessions))}
+
+	for _, session := range sessions {
+		res.Items = append(res.Items, toSession(session, current))
+	}
+
+	return res, nil
+}
+
+func (s *service) RevokeSession(ctx context.Context, userGUID, sessionID uuid.UUID) error {
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		ended, err := endSession(ctx, tx, userGUID, sessionID)
+		if err != nil {
+			return err
+		}
+
+		if ended == 0 {
+			return ErrSessionNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("revoking session: %w", err)
+	}
+
+	s.sessions.forget(sessionID)
+
+	return nil
+}
+
+func toSession(session query.Session, current uuid.UUID) *models.Session {
+	return &models.Session{
+		ID:         strfmt.UUID(session.ID.String()),
+		UserAgent:  session.UserAgent,
+		IP:         session.Ip,
+		CreatedAt:  strfmt.DateTime(session.CreatedAt),
+		LastSeenAt: strfmt.DateTime(session.LastSeenAt),
+		ExpiresAt:  strfmt.DateTime(session.ExpiresAt),
+		Current:    session.ID == current,
+	}
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Principal аутентифицированный субъект запроса.
+type Principal struct {
+	Subject uuid.UUID
+	Roles   []string
+	// SessionID сессия, в которой выдан access token. Пустой для edge-аутентификации.
+	SessionID uuid.UUID
+	// APIKeyID ключ API межсервисного клиента, Subject для него совпадает с APIKeyID.
+	// Пустой для пользователей.
+	APIKeyID uuid.UUID
+	// Scopes права ключа API.
+	Scopes []string
+}
+
+func (p *Principal) HasRole(roles ...string) bool {
+	for _, have := range p.Roles {
+		for _, want := range roles {
+			if have == want {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// Authenticator проверяет access token.
+type Authenticator interface {
+	Authenticate(ctx context.Context, accessToken string) (*Principal, error)
+}
+
+// NewAuthenticator используется там, где нужна только проверка токенов, например в edge-аутентификации.
+// Он проверяет подпись и срок токена без обращения к базе, поэтому токен завершенной сессии
+// принимается до истечения AccessTTL. Service.Authenticate проверяет и сессию.
+func NewAuthenticator(conf Config) Authenticator {
+	return &tokenIssuer{
+		secret:    []byte(conf.Secret),
+		issuer:    conf.Issuer,
+		accessTTL: conf.AccessTTL,
+	}
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles     []string `json:"roles,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+}
+
+type tokenIssuer struct {
+	secret    []byte
+	issuer    string
+	accessTTL time.Duration
+}
+
+func (t *tokenIssuer) issueAccess(subject, session uuid.UUID, roles []string, now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Issuer:    t.issuer,
+			Subject:   subject.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.accessTTL)),
+			ID:        uuid.NewString(),
+		},
+		Roles:     roles,
+		SessionID: session.String(),
+	})
+
+	signed, err := token.SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("sign access token: %w", err)
+	}
+
+	return signed, nil
+}
+
+func (t *tokenIssuer) parseAccess(raw string) (*Principal, error) {
+	var c claims
+
+	_, err := jwt.ParseWithClaims(raw, &c, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(t.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	subject, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: subject: %w", ErrInvalidToken, err)
+	}
+
+	var session uuid.UUID
+
+	// токены, выданные до появления сессий, не содержат sid
+	if c.SessionID != "" {
+		if session, err = uuid.Parse(c.SessionID); err != nil {
+			return nil, fmt.Errorf("%w: sid: %w", ErrInvalidToken, err)
+		}
+	}
+
+	return &Principal{Subject: subject, Roles: c.Roles, SessionID: session}, nil //nolint:exhaustruct
+}
+
+func (t *tokenIssuer) Authenticate(_ context.Context, accessToken string) (*Principal, error) {
+	return t.parseAccess(accessToken)
+}
+
+// newOpaqueToken возвращает случайный токен для клиента и его хэш для хранения в базе.
+// Используется для refresh token и токенов из писем.
+func newOpaqueToken() (string, string, error) {
+	buf := make([]byte, 32) //nolint:mnd
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("generate token: %w", err)
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+
+	return raw, hashToken(raw), nil
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+
+	return hex.EncodeToString(sum[:])
+}
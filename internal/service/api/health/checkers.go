@@ -0,0 +1,104 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrShuttingDown      = errors.New("shutdown in progress")
+	ErrMigrationDirty    = errors.New("migration is dirty")
+	ErrMigrationMismatch = errors.New("migration version mismatch")
+)
+
+type pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+type postgresChecker struct {
+	db pinger
+}
+
+// NewPostgresChecker проверяет доступность пула соединений к Postgres.
+func NewPostgresChecker(db pinger) Checker {
+	return &postgresChecker{db: db}
+}
+
+func (c *postgresChecker) Name() string {
+	return "postgres"
+}
+
+func (c *postgresChecker) Check(ctx context.Context) error {
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("ping: %w", err)
+	}
+
+	return nil
+}
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type migrationChecker struct {
+	db       querier
+	expected uint
+}
+
+// NewMigrationChecker сверяет примененную версию схемы с последней встроенной миграцией. Схема новее ожидаемой
+// не мешает готовности: при выкатке новая версия приложения применяет миграции, пока старая еще обслуживает запросы.
+func NewMigrationChecker(db querier, expected uint) Checker {
+	return &migrationChecker{db: db, expected: expected}
+}
+
+func (c *migrationChecker) Name() string {
+	return "migrations"
+}
+
+func (c *migrationChecker) Check(ctx context.Context) error {
+	var (
+		version uint
+		dirty   bool
+	)
+
+	err := c.db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+
+	if dirty {
+		return fmt.Errorf("%w: version %d", ErrMigrationDirty, version)
+	}
+
+	if version < c.expected {
+		return fmt.Errorf("%w: applied %d, expected at least %d", ErrMigrationMismatch, version, c.expected)
+	}
+
+	return nil
+}
+
+type shutdownChecker struct {
+	shuttingDown func() bool
+}
+
+// NewShutdownChecker снимает готовность, как только началась остановка приложения. Проверка выполняется
+// при каждом запросе в обход кэша, иначе балансировщик узнал бы об остановке только через CacheTTL.
+func NewShutdownChecker(shuttingDown func() bool) Checker {
+	return &shutdownChecker{shuttingDown: shuttingDown}
+}
+
+func (c *shutdownChecker) Name() string {
+	return "shutdown"
+}
+
+func (c *shutdownChecker) live() {}
+
+func (c *shutdownChecker) Check(_ context.Context) error {
+	if c.shuttingDown() {
+		return ErrShuttingDown
+	}
+
+	return nil
+}
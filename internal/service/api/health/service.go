@@ -0,0 +1,134 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"otusgruz/internal/models"
+)
+
+// Checker проверяет готовность одного компонента.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// liveChecker проверка без обращений к внешним системам, ее результат не кэшируется.
+type liveChecker interface {
+	live()
+}
+
+type Service interface {
+	// Ready возвращает отчет по всем компонентам и признак готовности.
+	Ready(ctx context.Context) (*models.HealthReport, bool)
+}
+
+type service struct {
+	checkers []Checker
+	timeout  time.Duration
+	ttl      time.Duration
+
+	mu sync.Mutex
+	// checks результаты кэшируемых проверок по индексам checkers, nil на месте живых
+	checks    []*models.HealthCheck
+	expiresAt time.Time
+}
+
+// NewService timeout ограничивает каждую проверку, результат переиспользуется в течение ttl.
+func NewService(timeout, ttl time.Duration, checkers ...Checker) Service {
+	return &service{ //nolint:exhaustruct
+		checkers: checkers,
+		timeout:  timeout,
+		ttl:      ttl,
+	}
+}
+
+func (s *service) Ready(ctx context.Context) (*models.HealthReport, bool) {
+	cached := s.cached(ctx)
+	checks := make([]*models.HealthCheck, len(s.checkers))
+	ready := true
+
+	for i, checker := range s.checkers {
+		checks[i] = cached[i]
+		if checks[i] == nil {
+			checks[i] = s.check(ctx, checker)
+		}
+
+		if *checks[i].Status != models.HealthCheckStatusOk {
+			ready = false
+		}
+	}
+
+	status := models.HealthReportStatusOk
+	if !ready {
+		status = models.HealthReportStatusFail
+	}
+
+	return &models.HealthReport{Status: &status, Checks: checks}, ready
+}
+
+// cached результаты кэшируемых проверок, перезапускает их по истечении ttl. Результаты запроса, отмененного
+// вызывающим или истекшего по его сроку, не кэшируются: проверки в нем упали не из-за компонентов.
+func (s *service) cached(ctx context.Context) []*models.HealthCheck {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.checks != nil && time.Now().Before(s.expiresAt) {
+		return s.checks
+	}
+
+	checks := s.run(ctx)
+	if ctx.Err() != nil {
+		return checks
+	}
+
+	s.checks = checks
+	s.expiresAt = time.Now().Add(s.ttl)
+
+	return s.checks
+}
+
+func (s *service) run(ctx context.Context) []*models.HealthCheck {
+	checks := make([]*models.HealthCheck, len(s.checkers))
+
+	var wg sync.WaitGroup
+
+	for i, checker := range s.checkers {
+		if _, ok := checker.(liveChecker); ok {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			checks[i] = s.check(ctx, checker)
+		}()
+	}
+
+	wg.Wait()
+
+	return checks
+}
+
+func (s *service) check(ctx context.Context, checker Checker) *models.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := checker.Check(ctx)
+	latency := time.Since(start).Milliseconds()
+
+	name := checker.Name()
+	status := models.HealthCheckStatusOk
+
+	res := &models.HealthCheck{Name: &name, Status: &status, LatencyMs: &latency} //nolint:exhaustruct
+	if err != nil {
+		status = models.HealthCheckStatusFail
+		res.Error = err.Error()
+	}
+
+	return res
+}
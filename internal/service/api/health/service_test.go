@@ -0,0 +1,134 @@
+package health
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"otusgruz/internal/models"
+)
+
+type countingChecker struct {
+	calls atomic.Int32
+	// block проверка ждет отмены контекста и возвращает его ошибку
+	block atomic.Bool
+}
+
+func (c *countingChecker) Name() string {
+	return "counting"
+}
+
+func (c *countingChecker) Check(ctx context.Context) error {
+	c.calls.Add(1)
+
+	if c.block.Load() {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func TestReadyChecksShutdownOutsideCache(t *testing.T) {
+	var shuttingDown atomic.Bool
+
+	counting := &countingChecker{} //nolint:exhaustruct
+	s := NewService(time.Second, time.Hour, NewShutdownChecker(shuttingDown.Load), counting)
+
+	if report, ready := s.Ready(context.Background()); !ready || *report.Status != models.HealthReportStatusOk {
+		t.Fatalf("ready %v, status %s, want ok", ready, *report.Status)
+	}
+
+	shuttingDown.Store(true)
+
+	report, ready := s.Ready(context.Background())
+	if ready || *report.Status != models.HealthReportStatusFail {
+		t.Fatalf("ready %v, status %s after shutdown started, want fail", ready, *report.Status)
+	}
+
+	if check := report.Checks[0]; *check.Name != "shutdown" || check.Error != ErrShuttingDown.Error() {
+		t.Errorf("first check %s: %q, want shutdown: %q", *check.Name, check.Error, ErrShuttingDown)
+	}
+
+	if *report.Checks[1].Status != models.HealthCheckStatusOk {
+		t.Errorf("cached check status %s, want ok", *report.Checks[1].Status)
+	}
+
+	if calls := counting.calls.Load(); calls != 1 {
+		t.Errorf("cached checker ran %d times within ttl, want 1", calls)
+	}
+}
+
+func TestReadyDoesNotCacheCallerContextErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  func() (context.Context, context.CancelFunc)
+	}{
+		{
+			name: "canceled",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx, cancel
+			},
+		},
+		{
+			name: "deadline exceeded",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Millisecond)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counting := &countingChecker{} //nolint:exhaustruct
+			counting.block.Store(true)
+
+			// срок проверки больше срока запроса, поэтому проверку прерывает контекст вызывающего
+			s := NewService(time.Hour, time.Hour, counting)
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			if _, ready := s.Ready(ctx); ready {
+				t.Fatal("ready with the caller context done, want not ready")
+			}
+
+			counting.block.Store(false)
+
+			report, ready := s.Ready(context.Background())
+			if !ready {
+				t.Errorf("ready false after the failed request, want the check rerun: %q", report.Checks[0].Error)
+			}
+
+			if calls := counting.calls.Load(); calls != 2 {
+				t.Errorf("checker ran %d times, want 2", calls)
+			}
+		})
+	}
+}
+
+func TestReadyCachesCheckTimeout(t *testing.T) {
+	counting := &countingChecker{} //nolint:exhaustruct
+	counting.block.Store(true)
+
+	s := NewService(time.Millisecond, time.Hour, counting)
+
+	if _, ready := s.Ready(context.Background()); ready {
+		t.Fatal("ready with the check timed out, want not ready")
+	}
+
+	counting.block.Store(false)
+
+	if _, ready := s.Ready(context.Background()); ready {
+		t.Error("ready within ttl after the check timed out, want the cached failure")
+	}
+
+	if calls := counting.calls.Load(); calls != 1 {
+		t.Errorf("checker ran %d times within ttl, want 1", calls)
+	}
+}
@@ -0,0 +1,202 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+
+	"otusgruz/internal/models"
+	query "otusgruz/internal/repo"
+	"otusgruz/internal/requestid"
+)
+
+// Actor субъект изменения: пользователь или ключ API межсервисного клиента. Нулевой Actor - системная операция.
+type Actor struct {
+	ID uuid.UUID
+	// Type models.UserAuditEntryActorTypeUser или models.UserAuditEntryActorTypeAPIKey
+	Type string
+}
+
+// UserActor изменение от имени пользователя guid.
+func UserActor(guid uuid.UUID) Actor {
+	return Actor{ID: guid, Type: models.UserAuditEntryActorTypeUser}
+}
+
+// APIKeyActor изменение от имени межсервисного клиента с ключом API id.
+func APIKeyActor(id uuid.UUID) Actor {
+	return Actor{ID: id, Type: models.UserAuditEntryActorTypeAPIKey}
+}
+
+func (a Actor) id() uuid.NullUUID {
+	return uuidArg(a.ID)
+}
+
+func (a Actor) kind() sql.NullString {
+	return sql.NullString{String: a.Type, Valid: a.ID != uuid.Nil}
+}
+
+// AuditRepo запрос, которым пишется журнал изменений.
+type AuditRepo interface {
+	InsertUserAudit(ctx context.Context, arg query.InsertUserAuditParams) error
+}
+
+// Audit пишет запись журнала изменений. Вызывается в транзакции изменения, nil означает отсутствие состояния,
+// details - подробности, которых не видно по состояниям, например выданная роль. Экспортирован для изменений
+// в обход Service: регистрации, входа через SSO, подтверждения email, сброса пароля и смены ролей.
+func Audit(
+	ctx context.Context, tx AuditRepo, action string, actor Actor, guid uuid.UUID, before, after *query.User, details map[string]string,
+) error {
+	requestID := requestid.FromContext(ctx)
+
+	var extra pqtype.NullRawMessage
+	if details != nil {
+		raw, _ := json.Marshal(details) //nolint:errchkjson
+		extra = pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+	}
+
+	return tx.InsertUserAudit(ctx, query.InsertUserAuditParams{
+		UserGuid:  guid,
+		Actor:     actor.id(),
+		ActorType: actor.kind(),
+		Action:    action,
+		Before:    snapshot(before),
+		After:     snapshot(after),
+		Details:   extra,
+		RequestID: sql.NullString{String: requestID, Valid: requestID != ""},
+	})
+}
+
+// mutate блокирует пользователя, выполняет fn, пишет в журнал состояния до и после и кладет событие
+// в outbox в одной транзакции.
+func (s *service) mutate(ctx context.Context, guid uuid.UUID, action string, actor Actor, fn func(tx repo) error) (query.User, error) {
+	var after query.User
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		before, err := tx.GetUserForUpdate(ctx, guid)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(tx); err != nil {
+			return err
+		}
+
+		if after, err = tx.GetUser(ctx, guid); err != nil {
+			return err
+		}
+
+		if err = Audit(ctx, tx, action, actor, guid, &before, &after, nil); err != nil {
+			return err
+		}
+
+		return Enqueue(ctx, tx, action, after)
+	})
+
+	return after, err
+}
+
+func (s *service) History(ctx context.Context, guid uuid.UUID, cursor string, limit int32) (*models.UserHistory, error) {
+	var after sql.NullInt64
+
+	if cursor != "" {
+		id, err := decodeHistoryCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		after = sql.NullInt64{Int64: id, Valid: true}
+	}
+
+	// запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	rows, err := s.repo.ListUserAudit(ctx, query.ListUserAuditParams{
+		UserGuid: guid,
+		CursorID: after,
+		RowLimit: limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing user history: %w", err)
+	}
+
+	if len(rows) == 0 && cursor == "" {
+		if _, err = s.repo.GetUser(ctx, guid); err != nil {
+			return nil, fmt.Errorf("getting user: %w", err)
+		}
+	}
+
+	res := &models.UserHistory{
+		Items: make([]*models.UserAuditEntry, 0, len(rows)),
+	}
+
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		res.NextCursor = encodeHistoryCursor(rows[len(rows)-1].ID)
+	}
+
+	for _, row := range rows {
+		entry, err := toAuditEntry(row)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items = append(res.Items, entry)
+	}
+
+	return res, nil
+}
+
+func toAuditEntry(row query.UserAudit) (*models.UserAuditEntry, error) {
+	entry := &models.UserAuditEntry{
+		ID:        row.ID,
+		Action:    row.Action,
+		Actor:     nullUUID(row.Actor),
+		ActorType: row.ActorType.String,
+		RequestID: row.RequestID.String,
+		CreatedAt: strfmt.DateTime(row.CreatedAt),
+	}
+
+	var err error
+
+	if entry.Before, err = fromSnapshot(row.Before); err != nil {
+		return nil, fmt.Errorf("decoding audit %d: %w", row.ID, err)
+	}
+
+	if entry.After, err = fromSnapshot(row.After); err != nil {
+		return nil, fmt.Errorf("decoding audit %d: %w", row.ID, err)
+	}
+
+	if row.Details.Valid {
+		if err = json.Unmarshal(row.Details.RawMessage, &entry.Details); err != nil {
+			return nil, fmt.Errorf("decoding audit %d: %w", row.ID, err)
+		}
+	}
+
+	return entry, nil
+}
+
+func snapshot(u *query.User) pqtype.NullRawMessage {
+	if u == nil {
+		return pqtype.NullRawMessage{} //nolint:exhaustruct
+	}
+
+	raw, _ := json.Marshal(toUserData(*u)) //nolint:errchkjson
+
+	return pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+}
+
+func fromSnapshot(raw pqtype.NullRawMessage) (*models.UserData, error) {
+	if !raw.Valid {
+		return nil, nil //nolint:nilnil
+	}
+
+	var res models.UserData
+	if err := json.Unmarshal(raw.RawMessage, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
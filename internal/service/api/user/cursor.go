@@ -0,0 +1,82 @@
+package user
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursor указывает на последнюю запись выданной страницы. List - отпечаток сортировки и фильтров,
+// для которых выдан курсор: в другом порядке или в другой выборке позиция указывала бы не на ту страницу.
+type cursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	GUID      uuid.UUID `json:"guid"`
+	List      string    `json:"list"`
+}
+
+// listKey отпечаток сортировки и фильтров списка. Limit в него не входит: размер страницы можно менять.
+func listKey(f ListFilter) string {
+	raw, _ := json.Marshal([]any{f.Ascending, f.Name, f.Occupation, f.IsDeleted, f.IncludeDeleted}) //nolint:errchkjson
+	sum := sha256.Sum256(raw)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+func encodeCursor(u query.User, list string) string {
+	raw, _ := json.Marshal(cursor{CreatedAt: u.CreatedAt, GUID: u.Guid, List: list}) //nolint:errchkjson
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor возвращает ErrInvalidCursor и для курсора, выданного с другими сортировкой или фильтрами.
+func decodeCursor(token, list string) (cursor, error) {
+	var c cursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	if err = json.Unmarshal(raw, &c); err != nil {
+		return c, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	if c.CreatedAt.IsZero() || c.GUID == uuid.Nil {
+		return c, ErrInvalidCursor
+	}
+
+	if c.List != list {
+		return c, fmt.Errorf("%w: issued for another sort order or filter", ErrInvalidCursor)
+	}
+
+	return c, nil
+}
+
+// encodeHistoryCursor указывает на последнюю запись журнала выданной страницы.
+func encodeHistoryCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeHistoryCursor(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return id, nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+func TestCursorBoundToListKey(t *testing.T) {
+	name := "alice"
+	base := ListFilter{Limit: 20, Name: &name}                                                //nolint:exhaustruct
+	token := encodeCursor(query.User{Guid: uuid.New(), CreatedAt: time.Now()}, listKey(base)) //nolint:exhaustruct
+
+	other := "bob"
+	tests := []struct {
+		name    string
+		edit    func(f *ListFilter)
+		wantErr bool
+	}{
+		{name: "same list", edit: func(*ListFilter) {}},
+		{name: "another page size", edit: func(f *ListFilter) { f.Limit = 50 }},
+		{name: "another sort", edit: func(f *ListFilter) { f.Ascending = true }, wantErr: true},
+		{name: "another filter", edit: func(f *ListFilter) { f.Name = &other }, wantErr: true},
+		{name: "filter removed", edit: func(f *ListFilter) { f.Name = nil }, wantErr: true},
+		{name: "deleted included", edit: func(f *ListFilter) { f.IncludeDeleted = true }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := base
+			tt.edit(&filter)
+
+			if _, err := decodeCursor(token, listKey(filter)); errors.Is(err, ErrInvalidCursor) != tt.wantErr {
+				t.Errorf("error %v, want invalid cursor %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package user
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx"
+)
+
+var (
+	ErrNotFound       = errors.New("user not found")
+	ErrAlreadyDeleted = errors.New("user already deleted")
+	ErrDeleted        = errors.New("user is deleted")
+	ErrNotDeleted     = errors.New("user is not deleted")
+	ErrConflict       = errors.New("user conflicts with existing data")
+	ErrValidation     = errors.New("invalid user data")
+	ErrEmailTaken     = errors.New("email already taken")
+	ErrUsernameTaken  = errors.New("username already taken")
+
+	ErrVersionMismatch = errors.New("user was modified, version does not match")
+
+	ErrIdempotencyMismatch = errors.New("idempotency key was already used with a different request")
+)
+
+// Коды ошибок Postgres, см. https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation     = "23505"
+	pgExclusionViolation  = "23P01"
+	pgNotNullViolation    = "23502"
+	pgCheckViolation      = "23514"
+	pgStringTruncation    = "22001"
+	pgInvalidTextRepr     = "22P02"
+	pgSerializationFailed = "40001"
+)
+
+// Уникальные индексы users, по которым определяется занятое поле.
+const (
+	usersEmailKey    = "users_email_key"
+	usersUsernameKey = "users_username_key"
+)
+
+type sqlStateError interface {
+	SQLState() string
+}
+
+// storageError переводит ошибки драйвера в доменные ошибки сервиса.
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+
+	var pgErr sqlStateError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.SQLState() {
+	case pgUniqueViolation:
+		return uniqueViolation(err)
+	case pgExclusionViolation, pgSerializationFailed:
+		return fmt.Errorf("%w: %w", ErrConflict, err)
+	case pgNotNullViolation, pgCheckViolation, pgStringTruncation, pgInvalidTextRepr:
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	default:
+		return err
+	}
+}
+
+// uniqueViolation по имени нарушенного индекса отличает занятый email или имя пользователя
+// от прочих конфликтов, например повторного guid.
+func uniqueViolation(err error) error {
+	var pgErr pgx.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case usersEmailKey:
+			return fmt.Errorf("%w: %w", ErrEmailTaken, err)
+		case usersUsernameKey:
+			return fmt.Errorf("%w: %w", ErrUsernameTaken, err)
+		}
+	}
+
+	return fmt.Errorf("%w: %w", ErrConflict, err)
+}
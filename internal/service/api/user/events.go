@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/outbox"
+	query "otusgruz/internal/repo"
+)
+
+var eventTypes = map[string]string{
+	models.UserAuditEntryActionCreate:  outbox.TypeUserCreated,
+	models.UserAuditEntryActionUpdate:  outbox.TypeUserUpdated,
+	models.UserAuditEntryActionDelete:  outbox.TypeUserDeleted,
+	models.UserAuditEntryActionRestore: outbox.TypeUserRestored,
+	models.UserAuditEntryActionPurge:   outbox.TypeUserPurged,
+}
+
+// EventRepo запросы, которыми событие ставится в outbox и в очередь доставок.
+type EventRepo interface {
+	InsertOutboxEvent(ctx context.Context, arg query.InsertOutboxEventParams) error
+	EnqueueWebhookDeliveries(ctx context.Context, arg query.EnqueueWebhookDeliveriesParams) error
+}
+
+// Enqueue пишет событие в outbox и ставит его доставки активным подпискам. Вызывается в транзакции изменения,
+// публикуют событие команды relay и dispatch. Экспортирован для сервисов, которые создают пользователей
+// в обход Service: регистрации и входа через SSO.
+func Enqueue(ctx context.Context, tx EventRepo, action string, user query.User) error {
+	payload, _ := json.Marshal(outbox.NewUserEvent(toUserData(user))) //nolint:errchkjson
+
+	event := query.InsertOutboxEventParams{
+		EventID:     uuid.New(),
+		AggregateID: user.Guid,
+		EventType:   eventTypes[action],
+		Payload:     payload,
+	}
+
+	if err := tx.InsertOutboxEvent(ctx, event); err != nil {
+		return err
+	}
+
+	return tx.EnqueueWebhookDeliveries(ctx, query.EnqueueWebhookDeliveriesParams{
+		EventID:   event.EventID,
+		EventType: event.EventType,
+		Payload:   event.Payload,
+	})
+}
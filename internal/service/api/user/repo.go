@@ -0,0 +1,209 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// Repo обертка над запросами sqlc, возвращающая доменные ошибки сервиса.
+type Repo struct {
+	db *sql.DB
+	tx *sql.Tx
+	q  *query.Queries
+}
+
+func NewRepo(db *sql.DB, q *query.Queries) *Repo {
+	return &Repo{db: db, q: q} //nolint:exhaustruct
+}
+
+// InTx выполняет fn в транзакции. Вложенный вызов переиспользует уже открытую транзакцию.
+func (r *Repo) InTx(ctx context.Context, fn func(tx repo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	if err = fn(&Repo{db: r.db, tx: tx, q: r.q.WithTx(tx)}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return storageError(fmt.Errorf("commit transaction: %w", err))
+	}
+
+	return nil
+}
+
+func (r *Repo) GetUser(ctx context.Context, guid uuid.UUID) (query.User, error) {
+	res, err := r.q.GetUser(ctx, guid)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetUserForUpdate(ctx context.Context, guid uuid.UUID) (query.User, error) {
+	res, err := r.q.GetUserForUpdate(ctx, guid)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) DeleteUser(ctx context.Context, arg query.DeleteUserParams) error {
+	affected, err := r.q.DeleteUser(ctx, arg)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if affected > 0 {
+		return nil
+	}
+
+	current, err := r.GetUser(ctx, arg.Guid)
+	if err != nil {
+		return err
+	}
+
+	if current.IsDeleted {
+		return ErrAlreadyDeleted
+	}
+
+	return ErrVersionMismatch
+}
+
+func (r *Repo) RestoreUser(ctx context.Context, guid uuid.UUID) (query.User, error) {
+	res, err := r.q.RestoreUser(ctx, guid)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return res, storageError(err)
+	}
+
+	if _, err = r.GetUser(ctx, guid); err != nil {
+		return res, err
+	}
+
+	return res, ErrNotDeleted
+}
+
+func (r *Repo) PurgeUser(ctx context.Context, guid uuid.UUID) error {
+	affected, err := r.q.PurgeUser(ctx, guid)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) PurgeDeletedUsers(ctx context.Context, arg query.PurgeDeletedUsersParams) ([]query.User, error) {
+	res, err := r.q.PurgeDeletedUsers(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertUser(ctx context.Context, arg query.InsertUserParams) (query.User, error) {
+	res, err := r.q.InsertUser(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) UpdateUser(ctx context.Context, arg query.UpdateUserParams) error {
+	affected, err := r.q.UpdateUser(ctx, arg)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return r.checkUpdated(ctx, arg.Guid, affected)
+}
+
+func (r *Repo) PatchUser(ctx context.Context, arg query.PatchUserParams) error {
+	affected, err := r.q.PatchUser(ctx, arg)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return r.checkUpdated(ctx, arg.Guid, affected)
+}
+
+// checkUpdated отличает отсутствующего пользователя от несовпадения версии, если запрос не изменил строк.
+func (r *Repo) checkUpdated(ctx context.Context, guid uuid.UUID, affected int64) error {
+	if affected > 0 {
+		return nil
+	}
+
+	current, err := r.GetUser(ctx, guid)
+	if err != nil {
+		return err
+	}
+
+	if current.IsDeleted {
+		return ErrDeleted
+	}
+
+	return ErrVersionMismatch
+}
+
+func (r *Repo) ListUsersAsc(ctx context.Context, arg query.ListUsersAscParams) ([]query.User, error) {
+	res, err := r.q.ListUsersAsc(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ListUsersDesc(ctx context.Context, arg query.ListUsersDescParams) ([]query.User, error) {
+	res, err := r.q.ListUsersDesc(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) CountUsers(ctx context.Context, arg query.CountUsersParams) (int64, error) {
+	res, err := r.q.CountUsers(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertIdempotencyKey(ctx context.Context, arg query.InsertIdempotencyKeyParams) (int64, error) {
+	res, err := r.q.InsertIdempotencyKey(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetIdempotencyKey(ctx context.Context, arg query.GetIdempotencyKeyParams) (query.IdempotencyKey, error) {
+	res, err := r.q.GetIdempotencyKey(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	res, err := r.q.DeleteExpiredIdempotencyKeys(ctx)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertUserAudit(ctx context.Context, arg query.InsertUserAuditParams) error {
+	return storageError(r.q.InsertUserAudit(ctx, arg))
+}
+
+func (r *Repo) ListUserAudit(ctx context.Context, arg query.ListUserAuditParams) ([]query.UserAudit, error) {
+	res, err := r.q.ListUserAudit(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) InsertOutboxEvent(ctx context.Context, arg query.InsertOutboxEventParams) error {
+	return storageError(r.q.InsertOutboxEvent(ctx, arg))
+}
+
+func (r *Repo) EnqueueWebhookDeliveries(ctx context.Context, arg query.EnqueueWebhookDeliveriesParams) error {
+	return storageError(r.q.EnqueueWebhookDeliveries(ctx, arg))
+}
@@ -0,0 +1,177 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// memRepo хранилище в памяти для тестов сервиса. Реализует только запросы, которые нужны тестам,
+// вызов остальных паникует на встроенном nil repo. Транзакция откатывает изменения, если fn вернула ошибку.
+type memRepo struct {
+	repo
+
+	users  map[uuid.UUID]query.User
+	keys   map[query.GetIdempotencyKeyParams]query.IdempotencyKey
+	audit  []query.InsertUserAuditParams
+	events []query.InsertOutboxEventParams
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{ //nolint:exhaustruct
+		users: make(map[uuid.UUID]query.User),
+		keys:  make(map[query.GetIdempotencyKeyParams]query.IdempotencyKey),
+	}
+}
+
+func (m *memRepo) InTx(_ context.Context, fn func(tx repo) error) error {
+	users, keys := maps.Clone(m.users), maps.Clone(m.keys)
+	audit, events := len(m.audit), len(m.events)
+
+	if err := fn(m); err != nil {
+		m.users, m.keys = users, keys
+		m.audit, m.events = m.audit[:audit], m.events[:events]
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *memRepo) GetUser(_ context.Context, guid uuid.UUID) (query.User, error) {
+	user, ok := m.users[guid]
+	if !ok {
+		return user, ErrNotFound
+	}
+
+	return user, nil
+}
+
+func (m *memRepo) GetUserForUpdate(ctx context.Context, guid uuid.UUID) (query.User, error) {
+	return m.GetUser(ctx, guid)
+}
+
+// PatchUser меняет поля, как запрос PatchUser: обязательные - если переданы, необязательные - по флагу set.
+func (m *memRepo) PatchUser(_ context.Context, arg query.PatchUserParams) error {
+	user, ok := m.users[arg.Guid]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if arg.Name.Valid {
+		user.Name = arg.Name.String
+	}
+
+	if arg.Occupation.Valid {
+		user.Occupation = arg.Occupation.String
+	}
+
+	for _, field := range []struct {
+		set   bool
+		value sql.NullString
+		dst   *sql.NullString
+	}{
+		{arg.SetUsername, arg.Username, &user.Username},
+		{arg.SetEmail, arg.Email, &user.Email},
+		{arg.SetPhone, arg.Phone, &user.Phone},
+		{arg.SetFirstName, arg.FirstName, &user.FirstName},
+		{arg.SetLastName, arg.LastName, &user.LastName},
+	} {
+		if field.set {
+			*field.dst = field.value
+		}
+	}
+
+	if arg.SetBirthDate {
+		user.BirthDate = arg.BirthDate
+	}
+
+	user.Version++
+	m.users[arg.Guid] = user
+
+	return nil
+}
+
+// InsertUser проверяет уникальность email и имени пользователя без учета регистра, как индексы users.
+func (m *memRepo) InsertUser(_ context.Context, arg query.InsertUserParams) (query.User, error) {
+	for _, user := range m.users {
+		if arg.Email.Valid && user.Email.Valid && strings.EqualFold(arg.Email.String, user.Email.String) {
+			return query.User{}, ErrEmailTaken //nolint:exhaustruct
+		}
+
+		if arg.Username.Valid && user.Username.Valid && strings.EqualFold(arg.Username.String, user.Username.String) {
+			return query.User{}, ErrUsernameTaken //nolint:exhaustruct
+		}
+	}
+
+	user := query.User{ //nolint:exhaustruct
+		Guid:       arg.Guid,
+		Name:       arg.Name,
+		Occupation: arg.Occupation,
+		Username:   arg.Username,
+		Email:      arg.Email,
+		Phone:      arg.Phone,
+		FirstName:  arg.FirstName,
+		LastName:   arg.LastName,
+		BirthDate:  arg.BirthDate,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Version:    1,
+	}
+	m.users[arg.Guid] = user
+
+	return user, nil
+}
+
+func (m *memRepo) InsertIdempotencyKey(_ context.Context, arg query.InsertIdempotencyKeyParams) (int64, error) {
+	id := query.GetIdempotencyKeyParams{Principal: arg.Principal, Key: arg.Key}
+	if key, ok := m.keys[id]; ok && key.ExpiresAt.After(time.Now()) {
+		return 0, nil
+	}
+
+	m.keys[id] = query.IdempotencyKey{
+		Key:         arg.Key,
+		RequestHash: arg.RequestHash,
+		UserGuid:    arg.UserGuid,
+		CreatedAt:   time.Now(),
+		Principal:   arg.Principal,
+		ExpiresAt:   time.Now().Add(time.Duration(arg.TtlSeconds * float64(time.Second))),
+	}
+
+	return 1, nil
+}
+
+func (m *memRepo) GetIdempotencyKey(_ context.Context, arg query.GetIdempotencyKeyParams) (query.IdempotencyKey, error) {
+	key, ok := m.keys[arg]
+	if !ok {
+		return key, ErrNotFound
+	}
+
+	return key, nil
+}
+
+func (m *memRepo) DeleteExpiredIdempotencyKeys(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (m *memRepo) InsertUserAudit(_ context.Context, arg query.InsertUserAuditParams) error {
+	m.audit = append(m.audit, arg)
+
+	return nil
+}
+
+func (m *memRepo) InsertOutboxEvent(_ context.Context, arg query.InsertOutboxEventParams) error {
+	m.events = append(m.events, arg)
+
+	return nil
+}
+
+func (m *memRepo) EnqueueWebhookDeliveries(context.Context, query.EnqueueWebhookDeliveriesParams) error {
+	return nil
+}
@@ -2,10 +2,20 @@ package user
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 
 	"otusgruz/internal/models"
 	query "otusgruz/internal/repo"
@@ -13,44 +23,190 @@ import (
 
 type repo interface {
 	GetUser(ctx context.Context, guid uuid.UUID) (query.User, error)
-	DeleteUser(ctx context.Context, guid uuid.UUID) error
-	InsertUser(ctx context.Context, arg query.InsertUserParams) error
+	GetUserForUpdate(ctx context.Context, guid uuid.UUID) (query.User, error)
+	DeleteUser(ctx context.Context, arg query.DeleteUserParams) error
+	InsertUser(ctx context.Context, arg query.InsertUserParams) (query.User, error)
 	UpdateUser(ctx context.Context, arg query.UpdateUserParams) error
+	PatchUser(ctx context.Context, arg query.PatchUserParams) error
+	ListUsersAsc(ctx context.Context, arg query.ListUsersAscParams) ([]query.User, error)
+	ListUsersDesc(ctx context.Context, arg query.ListUsersDescParams) ([]query.User, error)
+	CountUsers(ctx context.Context, arg query.CountUsersParams) (int64, error)
+	PurgeUser(ctx context.Context, guid uuid.UUID) error
+	RestoreUser(ctx context.Context, guid uuid.UUID) (query.User, error)
+	PurgeDeletedUsers(ctx context.Context, arg query.PurgeDeletedUsersParams) ([]query.User, error)
+	InsertIdempotencyKey(ctx context.Context, arg query.InsertIdempotencyKeyParams) (int64, error)
+	GetIdempotencyKey(ctx context.Context, arg query.GetIdempotencyKeyParams) (query.IdempotencyKey, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	InsertUserAudit(ctx context.Context, arg query.InsertUserAuditParams) error
+	ListUserAudit(ctx context.Context, arg query.ListUserAuditParams) ([]query.UserAudit, error)
+	InsertOutboxEvent(ctx context.Context, arg query.InsertOutboxEventParams) error
+	EnqueueWebhookDeliveries(ctx context.Context, arg query.EnqueueWebhookDeliveriesParams) error
+	InTx(ctx context.Context, fn func(tx repo) error) error
 }
 
+const (
+	// idempotencyCleanupInterval как часто экземпляр удаляет истекшие ключи идемпотентности.
+	idempotencyCleanupInterval = time.Minute
+	// maxCount предел total_count списка: точный count(*) по всей таблице или с поиском подстроки
+	// на каждую страницу слишком дорог.
+	maxCount = 10000
+)
+
 type service struct {
 	repo repo
+	// idempotencyTTL срок действия Idempotency-Key
+	idempotencyTTL time.Duration
+	// cleanedAt время последней очистки ключей идемпотентности в наносекундах unix
+	cleanedAt atomic.Int64
 }
 
+// Service управляет пользователями. Изменяющие методы принимают actor - субъекта, от имени которого
+// выполняется изменение, и пишут его в журнал изменений. Нулевой Actor означает системную операцию.
 type Service interface {
-	GetUser(ctx context.Context, guid uuid.UUID) (*models.UserData, error)
-	DeleteUser(ctx context.Context, guid uuid.UUID) (*models.DefaultStatusResponse, error)
-	UpdateUser(ctx context.Context, guid uuid.UUID, info *models.UserCreateParams) (*models.DefaultStatusResponse, error)
-	CreateUser(ctx context.Context, info *models.UserCreateParams) (*models.DefaultStatusResponse, error)
+	// GetUser возвращает ErrDeleted для удаленного пользователя, если не передан includeDeleted.
+	GetUser(ctx context.Context, guid uuid.UUID, includeDeleted bool) (*models.UserData, error)
+	// DeleteUser и UpdateUser при непустой version меняют пользователя, только если его версия совпадает.
+	DeleteUser(ctx context.Context, guid uuid.UUID, version *int64, actor Actor) (*models.DefaultStatusResponse, error)
+	UpdateUser(ctx context.Context, guid uuid.UUID, version *int64, info *models.UserCreateParams, actor Actor) (*models.DefaultStatusResponse, error)
+	// PatchUser меняет только переданные поля, nil поля остаются без изменений. Необязательные поля,
+	// перечисленные в clear по имени в JSON, очищаются.
+	PatchUser(ctx context.Context, guid uuid.UUID, version *int64, patch *models.UserPatchParams, clear []string, actor Actor) (*models.DefaultStatusResponse, error)
+	// CreateUser при непустом idempotencyKey возвращает пользователя, созданного тем же actor с этим ключом
+	// в течение срока действия ключа, а с другим телом запроса - ErrIdempotencyMismatch.
+	CreateUser(ctx context.Context, info *models.UserCreateParams, idempotencyKey string, actor Actor) (*models.UserData, error)
+	// ListUsers считает total_count не дальше maxCount. Курсор привязан к сортировке и фильтрам,
+	// с другими он отклоняется с ErrInvalidCursor.
+	ListUsers(ctx context.Context, filter ListFilter) (*models.UserList, error)
+	EnsureUser(ctx context.Context, guid uuid.UUID, info *models.UserCreateParams) (bool, error)
+	RestoreUser(ctx context.Context, guid uuid.UUID, actor Actor) (*models.UserData, error)
+	// History возвращает журнал изменений пользователя от новых записей к старым.
+	History(ctx context.Context, guid uuid.UUID, cursor string, limit int32) (*models.UserHistory, error)
+	PurgeUser(ctx context.Context, guid uuid.UUID) error
+	// PurgeDeleted физически удаляет пользователей, удаленных раньше before, пачками по batchSize.
+	// О каждом удаленном пишет запись журнала purge и событие user.purged.
+	PurgeDeleted(ctx context.Context, before time.Time, batchSize int32) (int64, error)
+}
+
+// ListFilter параметры постраничной выдачи пользователей.
+// Без IncludeDeleted удаленные пользователи не выдаются, а IsDeleted не учитывается.
+type ListFilter struct {
+	Cursor         string
+	Limit          int32
+	Name           *string
+	Occupation     *string
+	IsDeleted      *bool
+	IncludeDeleted bool
+	Ascending      bool
 }
 
-func NewService(repo repo) Service {
-	return &service{
-		repo: repo,
+func NewService(repo repo, idempotencyTTL time.Duration) Service {
+	s := &service{ //nolint:exhaustruct
+		repo:           repo,
+		idempotencyTTL: idempotencyTTL,
 	}
+	s.cleanedAt.Store(time.Now().UnixNano())
+
+	return s
 }
 
-func (s *service) GetUser(ctx context.Context, guid uuid.UUID) (*models.UserData, error) {
+func (s *service) GetUser(ctx context.Context, guid uuid.UUID, includeDeleted bool) (*models.UserData, error) {
 	res, err := s.repo.GetUser(ctx, guid)
 	if err != nil {
 		return nil, fmt.Errorf("getting user: %w", err)
 	}
 
-	return &models.UserData{
-		GUID:       strfmt.UUID(res.Guid.String()),
-		IsDeleted:  res.IsDeleted,
-		Name:       res.Name,
-		Occupation: res.Occupation,
-	}, nil
+	if res.IsDeleted && !includeDeleted {
+		return nil, ErrDeleted
+	}
+
+	return toUserData(res), nil
 }
 
-func (s *service) DeleteUser(ctx context.Context, guid uuid.UUID) (*models.DefaultStatusResponse, error) {
-	err := s.repo.DeleteUser(ctx, guid)
+func (s *service) ListUsers(ctx context.Context, filter ListFilter) (*models.UserList, error) {
+	var after cursor
+
+	if filter.Cursor != "" {
+		var err error
+
+		after, err = decodeCursor(filter.Cursor, listKey(filter))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	name, occupation, isDeleted := likeArg(filter.Name), likeArg(filter.Occupation), boolArg(filter.IsDeleted)
+	if !filter.IncludeDeleted {
+		isDeleted = sql.NullBool{Bool: false, Valid: true}
+	}
+	cursorCreatedAt := sql.NullTime{Time: after.CreatedAt, Valid: filter.Cursor != ""}
+	cursorGUID := uuid.NullUUID{UUID: after.GUID, Valid: filter.Cursor != ""}
+
+	// запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	var (
+		rows []query.User
+		err  error
+	)
+
+	if filter.Ascending {
+		rows, err = s.repo.ListUsersAsc(ctx, query.ListUsersAscParams{
+			Name:            name,
+			Occupation:      occupation,
+			IsDeleted:       isDeleted,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorGuid:      cursorGUID,
+			RowLimit:        filter.Limit + 1,
+		})
+	} else {
+		rows, err = s.repo.ListUsersDesc(ctx, query.ListUsersDescParams{
+			Name:            name,
+			Occupation:      occupation,
+			IsDeleted:       isDeleted,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorGuid:      cursorGUID,
+			RowLimit:        filter.Limit + 1,
+		})
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+
+	total, err := s.repo.CountUsers(ctx, query.CountUsersParams{
+		Name:       name,
+		Occupation: occupation,
+		IsDeleted:  isDeleted,
+		RowCap:     maxCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("counting users: %w", err)
+	}
+
+	res := &models.UserList{
+		Items:      make([]*models.UserData, 0, len(rows)),
+		TotalCount: total,
+	}
+
+	if len(rows) > int(filter.Limit) {
+		rows = rows[:filter.Limit]
+		res.NextCursor = encodeCursor(rows[len(rows)-1], listKey(filter))
+	}
+
+	for _, row := range rows {
+		res.Items = append(res.Items, toUserData(row))
+	}
+
+	return res, nil
+}
+
+func (s *service) DeleteUser(ctx context.Context, guid uuid.UUID, version *int64, actor Actor) (*models.DefaultStatusResponse, error) {
+	_, err := s.mutate(ctx, guid, models.UserAuditEntryActionDelete, actor, func(tx repo) error {
+		return tx.DeleteUser(ctx, query.DeleteUserParams{
+			Guid:          guid,
+			Version:       int64Arg(version),
+			DeletedBy:     actor.id(),
+			DeletedByType: actor.kind(),
+		})
+	})
 	if err != nil {
 		return nil, fmt.Errorf("deleting user: %w", err)
 	}
@@ -61,11 +217,20 @@ func (s *service) DeleteUser(ctx context.Context, guid uuid.UUID) (*models.Defau
 	}, nil
 }
 
-func (s *service) UpdateUser(ctx context.Context, guid uuid.UUID, info *models.UserCreateParams) (*models.DefaultStatusResponse, error) {
-	err := s.repo.UpdateUser(ctx, query.UpdateUserParams{
-		Guid:       guid,
-		Occupation: info.Occupation,
-		Name:       info.Name,
+func (s *service) UpdateUser(ctx context.Context, guid uuid.UUID, version *int64, info *models.UserCreateParams, actor Actor) (*models.DefaultStatusResponse, error) {
+	_, err := s.mutate(ctx, guid, models.UserAuditEntryActionUpdate, actor, func(tx repo) error {
+		return tx.UpdateUser(ctx, query.UpdateUserParams{
+			Guid:       guid,
+			Occupation: info.Occupation,
+			Name:       info.Name,
+			Username:   optionalArg(info.Username),
+			Email:      optionalArg(info.Email),
+			Phone:      optionalArg(info.Phone),
+			FirstName:  optionalArg(info.FirstName),
+			LastName:   optionalArg(info.LastName),
+			BirthDate:  dateArg(info.BirthDate),
+			Version:    int64Arg(version),
+		})
 	})
 	if err != nil {
 		return nil, fmt.Errorf("updating user: %w", err)
@@ -77,18 +242,359 @@ func (s *service) UpdateUser(ctx context.Context, guid uuid.UUID, info *models.U
 	}, nil
 }
 
-func (s *service) CreateUser(ctx context.Context, info *models.UserCreateParams) (*models.DefaultStatusResponse, error) {
-	err := s.repo.InsertUser(ctx, query.InsertUserParams{
-		Guid:       uuid.New(),
-		Occupation: info.Occupation,
-		Name:       info.Name,
+func (s *service) PatchUser(ctx context.Context, guid uuid.UUID, version *int64, patch *models.UserPatchParams, clear []string, actor Actor) (*models.DefaultStatusResponse, error) {
+	// поле меняется, если передано значение или оно очищается
+	set := func(field string, passed bool) bool {
+		return passed || slices.Contains(clear, field)
+	}
+
+	_, err := s.mutate(ctx, guid, models.UserAuditEntryActionUpdate, actor, func(tx repo) error {
+		return tx.PatchUser(ctx, query.PatchUserParams{
+			Guid:         guid,
+			Occupation:   stringArg(patch.Occupation),
+			Name:         stringArg(patch.Name),
+			SetUsername:  set("username", patch.Username != nil),
+			Username:     stringArg(patch.Username),
+			SetEmail:     set("email", patch.Email != nil),
+			Email:        stringArg(patch.Email),
+			SetPhone:     set("phone", patch.Phone != nil),
+			Phone:        stringArg(patch.Phone),
+			SetFirstName: set("first_name", patch.FirstName != nil),
+			FirstName:    stringArg(patch.FirstName),
+			SetLastName:  set("last_name", patch.LastName != nil),
+			LastName:     stringArg(patch.LastName),
+			SetBirthDate: set("birth_date", patch.BirthDate != nil),
+			BirthDate:    dateArg(patch.BirthDate),
+			Version:      int64Arg(version),
+		})
 	})
 	if err != nil {
-		return nil, fmt.Errorf("creating user: %w", err)
+		return nil, fmt.Errorf("patching user: %w", err)
 	}
 
 	return &models.DefaultStatusResponse{
 		Code:    "01",
-		Message: "Successfully created",
+		Message: "Successfully updated",
 	}, nil
 }
+
+// errIdempotentReplay откатывает транзакцию, если ключ уже был использован.
+var errIdempotentReplay = errors.New("idempotent replay")
+
+func (s *service) CreateUser(ctx context.Context, info *models.UserCreateParams, idempotencyKey string, actor Actor) (*models.UserData, error) {
+	var created query.User
+
+	guid := uuid.New()
+	requestHash := hashRequest(info)
+
+	err := s.repo.InTx(ctx, func(tx repo) error {
+		// ключ занимается до вставки пользователя: повтор запроса не должен упасть на занятом email или имени
+		// пользователя. При конкурентных запросах с одним ключом вставка ждет завершения первой транзакции.
+		if idempotencyKey != "" {
+			inserted, err := tx.InsertIdempotencyKey(ctx, query.InsertIdempotencyKeyParams{
+				Principal:   actor.ID,
+				Key:         idempotencyKey,
+				RequestHash: requestHash,
+				UserGuid:    guid,
+				TtlSeconds:  s.idempotencyTTL.Seconds(),
+			})
+			if err != nil {
+				return err
+			}
+
+			if inserted == 0 {
+				return errIdempotentReplay
+			}
+		}
+
+		var err error
+
+		created, err = tx.InsertUser(ctx, insertParams(guid, info))
+		if err != nil {
+			return err
+		}
+
+		if err = Audit(ctx, tx, models.UserAuditEntryActionCreate, actor, created.Guid, nil, &created, nil); err != nil {
+			return err
+		}
+
+		return Enqueue(ctx, tx, models.UserAuditEntryActionCreate, created)
+	})
+	if errors.Is(err, errIdempotentReplay) {
+		return s.replayCreate(ctx, actor, idempotencyKey, requestHash)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("creating user: %w", err)
+	}
+
+	if idempotencyKey != "" {
+		s.cleanupIdempotencyKeys(ctx)
+	}
+
+	return toUserData(created), nil
+}
+
+func (s *service) replayCreate(ctx context.Context, actor Actor, idempotencyKey, requestHash string) (*models.UserData, error) {
+	key, err := s.repo.GetIdempotencyKey(ctx, query.GetIdempotencyKeyParams{Principal: actor.ID, Key: idempotencyKey})
+	if err != nil {
+		return nil, fmt.Errorf("getting idempotency key: %w", err)
+	}
+
+	if key.RequestHash != requestHash {
+		return nil, ErrIdempotencyMismatch
+	}
+
+	res, err := s.repo.GetUser(ctx, key.UserGuid)
+	if err != nil {
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+
+	return toUserData(res), nil
+}
+
+// cleanupIdempotencyKeys выполняется одним из запросов не чаще раза в idempotencyCleanupInterval.
+// Ошибка очистки не мешает запросу: истекший ключ все равно занимается заново при вставке.
+func (s *service) cleanupIdempotencyKeys(ctx context.Context) {
+	last := s.cleanedAt.Load()
+	now := time.Now().UnixNano()
+
+	if time.Duration(now-last) < idempotencyCleanupInterval || !s.cleanedAt.CompareAndSwap(last, now) {
+		return
+	}
+
+	if _, err := s.repo.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("delete expired idempotency keys")
+	}
+}
+
+// EnsureUser создает пользователя с заданным guid, если его еще нет. Возвращает true, если пользователь создан.
+func (s *service) EnsureUser(ctx context.Context, guid uuid.UUID, info *models.UserCreateParams) (bool, error) {
+	_, err := s.repo.GetUser(ctx, guid)
+	if err == nil {
+		return false, nil
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		return false, fmt.Errorf("getting user: %w", err)
+	}
+
+	err = s.repo.InTx(ctx, func(tx repo) error {
+		created, err := tx.InsertUser(ctx, insertParams(guid, info))
+		if err != nil {
+			return err
+		}
+
+		if err = Audit(ctx, tx, models.UserAuditEntryActionCreate, Actor{}, guid, nil, &created, nil); err != nil { //nolint:exhaustruct
+			return err
+		}
+
+		return Enqueue(ctx, tx, models.UserAuditEntryActionCreate, created)
+	})
+	if errors.Is(err, ErrConflict) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("creating user: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *service) RestoreUser(ctx context.Context, guid uuid.UUID, actor Actor) (*models.UserData, error) {
+	res, err := s.mutate(ctx, guid, models.UserAuditEntryActionRestore, actor, func(tx repo) error {
+		_, err := tx.RestoreUser(ctx, guid)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restoring user: %w", err)
+	}
+
+	return toUserData(res), nil
+}
+
+// PurgeUser физически удаляет пользователя.
+func (s *service) PurgeUser(ctx context.Context, guid uuid.UUID) error {
+	if err := s.repo.PurgeUser(ctx, guid); err != nil {
+		return fmt.Errorf("purging user: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) PurgeDeleted(ctx context.Context, before time.Time, batchSize int32) (int64, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("%w: batch size must be positive", ErrValidation)
+	}
+
+	var total int64
+
+	// каждая пачка удаляется отдельной транзакцией, чтобы не держать долгие блокировки
+	for {
+		var purged []query.User
+
+		err := s.repo.InTx(ctx, func(tx repo) error {
+			var err error
+
+			purged, err = tx.PurgeDeletedUsers(ctx, query.PurgeDeletedUsersParams{
+				DeletedBefore: before,
+				BatchSize:     batchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			for i := range purged {
+				user := &purged[i]
+
+				if err = Audit(ctx, tx, models.UserAuditEntryActionPurge, Actor{}, user.Guid, user, nil, nil); err != nil { //nolint:exhaustruct
+					return err
+				}
+
+				if err = Enqueue(ctx, tx, models.UserAuditEntryActionPurge, *user); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, fmt.Errorf("purging deleted users: %w", err)
+		}
+
+		total += int64(len(purged))
+
+		zerolog.Ctx(ctx).Debug().Int("purged", len(purged)).Int64("total", total).Msg("purged batch of deleted users")
+
+		if len(purged) < int(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func insertParams(guid uuid.UUID, info *models.UserCreateParams) query.InsertUserParams {
+	return query.InsertUserParams{
+		Guid:       guid,
+		Occupation: info.Occupation,
+		Name:       info.Name,
+		Username:   optionalArg(info.Username),
+		Email:      optionalArg(info.Email),
+		Phone:      optionalArg(info.Phone),
+		FirstName:  optionalArg(info.FirstName),
+		LastName:   optionalArg(info.LastName),
+		BirthDate:  dateArg(info.BirthDate),
+	}
+}
+
+func toUserData(res query.User) *models.UserData {
+	return &models.UserData{
+		GUID:            strfmt.UUID(res.Guid.String()),
+		IsDeleted:       res.IsDeleted,
+		Name:            res.Name,
+		Occupation:      res.Occupation,
+		Username:        res.Username.String,
+		Email:           strfmt.Email(res.Email.String),
+		EmailVerifiedAt: nullDateTime(res.EmailVerifiedAt),
+		Phone:           res.Phone.String,
+		FirstName:       res.FirstName.String,
+		LastName:        res.LastName.String,
+		BirthDate:       nullDate(res.BirthDate),
+		CreatedAt:       strfmt.DateTime(res.CreatedAt),
+		UpdatedAt:       strfmt.DateTime(res.UpdatedAt),
+		Version:         res.Version,
+		DeletedAt:       nullDateTime(res.DeletedAt),
+		DeletedBy:       nullUUID(res.DeletedBy),
+		DeletedByType:   res.DeletedByType.String,
+	}
+}
+
+func nullDateTime(v sql.NullTime) *strfmt.DateTime {
+	if !v.Valid {
+		return nil
+	}
+
+	res := strfmt.DateTime(v.Time)
+
+	return &res
+}
+
+func nullDate(v sql.NullTime) *strfmt.Date {
+	if !v.Valid {
+		return nil
+	}
+
+	res := strfmt.Date(v.Time)
+
+	return &res
+}
+
+func nullUUID(v uuid.NullUUID) *strfmt.UUID {
+	if !v.Valid {
+		return nil
+	}
+
+	res := strfmt.UUID(v.UUID.String())
+
+	return &res
+}
+
+func hashRequest(info *models.UserCreateParams) string {
+	raw, _ := json.Marshal(info) //nolint:errchkjson
+	sum := sha256.Sum256(raw)
+
+	return hex.EncodeToString(sum[:])
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likeArg экранирует спецсимволы LIKE, чтобы фильтр работал как поиск подстроки.
+func likeArg(v *string) sql.NullString {
+	if v == nil {
+		return sql.NullString{} //nolint:exhaustruct
+	}
+
+	return sql.NullString{String: likeEscaper.Replace(*v), Valid: true}
+}
+
+func stringArg[T ~string](v *T) sql.NullString {
+	if v == nil {
+		return sql.NullString{} //nolint:exhaustruct
+	}
+
+	return sql.NullString{String: string(*v), Valid: true}
+}
+
+// optionalArg для необязательных полей создания и полной замены, пустая строка означает отсутствие значения.
+func optionalArg[T ~string](v T) sql.NullString {
+	return sql.NullString{String: string(v), Valid: v != ""}
+}
+
+func dateArg(v *strfmt.Date) sql.NullTime {
+	if v == nil {
+		return sql.NullTime{} //nolint:exhaustruct
+	}
+
+	return sql.NullTime{Time: time.Time(*v), Valid: true}
+}
+
+func uuidArg(v uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: v, Valid: v != uuid.Nil}
+}
+
+func boolArg(v *bool) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{} //nolint:exhaustruct
+	}
+
+	return sql.NullBool{Bool: *v, Valid: true}
+}
+
+func int64Arg(v *int64) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{} //nolint:exhaustruct
+	}
+
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+)
+
+func TestCreateUserReplaysIdempotentRetry(t *testing.T) {
+	r := newMemRepo()
+	s := NewService(r, time.Hour)
+	actor := UserActor(uuid.New())
+
+	info := &models.UserCreateParams{Name: "Alice", Occupation: "QA", Email: "alice@example.com"} //nolint:exhaustruct
+
+	first, err := s.CreateUser(context.Background(), info, "retry-key", actor)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	retry, err := s.CreateUser(context.Background(), info, "retry-key", actor)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+
+	if retry.GUID != first.GUID {
+		t.Errorf("retry returned user %s, want %s", retry.GUID, first.GUID)
+	}
+
+	if len(r.users) != 1 || len(r.audit) != 1 || len(r.events) != 1 {
+		t.Errorf("%d users, %d audit records and %d events after retry, want 1", len(r.users), len(r.audit), len(r.events))
+	}
+
+	other := *info
+	other.Name = "Bob"
+
+	if _, err = s.CreateUser(context.Background(), &other, "retry-key", actor); !errors.Is(err, ErrIdempotencyMismatch) {
+		t.Errorf("reuse with another body: error %v, want %v", err, ErrIdempotencyMismatch)
+	}
+
+	if _, err = s.CreateUser(context.Background(), info, "retry-key", UserActor(uuid.New())); !errors.Is(err, ErrEmailTaken) {
+		t.Errorf("same key of another principal: error %v, want %v", err, ErrEmailTaken)
+	}
+}
+
+func TestPatchUserClearsOnlyListedFields(t *testing.T) {
+	r := newMemRepo()
+	s := NewService(r, time.Hour)
+	actor := UserActor(uuid.New())
+
+	created, err := s.CreateUser(context.Background(), &models.UserCreateParams{ //nolint:exhaustruct
+		Name:       "Alice",
+		Occupation: "QA",
+		Email:      "alice@example.com",
+		Phone:      "+79991234567",
+	}, "", actor)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	guid := uuid.MustParse(created.GUID.String())
+	firstName := "Alice"
+
+	_, err = s.PatchUser(context.Background(), guid, nil, &models.UserPatchParams{FirstName: &firstName}, []string{"email"}, actor) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+
+	patched := r.users[guid]
+	if patched.Email.Valid || patched.Phone.String != "+79991234567" || patched.FirstName.String != firstName || patched.Name != "Alice" {
+		t.Errorf("patched user %+v, want cleared email, kept phone and name, first name %q", patched, firstName)
+	}
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotFound         = errors.New("webhook not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrNotDead          = errors.New("webhook delivery is not dead")
+	ErrValidation       = errors.New("invalid webhook data")
+)
+
+// Коды ошибок Postgres, см. https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgNotNullViolation = "23502"
+	pgCheckViolation   = "23514"
+	pgStringTruncation = "22001"
+)
+
+type sqlStateError interface {
+	SQLState() string
+}
+
+// storageError переводит ошибки драйвера в доменные ошибки сервиса.
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+
+	var pgErr sqlStateError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.SQLState() {
+	case pgNotNullViolation, pgCheckViolation, pgStringTruncation:
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+// Repo обертка над запросами sqlc, возвращающая доменные ошибки сервиса.
+type Repo struct {
+	q *query.Queries
+}
+
+func NewRepo(q *query.Queries) *Repo {
+	return &Repo{q: q}
+}
+
+func (r *Repo) InsertWebhook(ctx context.Context, arg query.InsertWebhookParams) (query.Webhook, error) {
+	res, err := r.q.InsertWebhook(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) GetWebhook(ctx context.Context, arg query.GetWebhookParams) (query.Webhook, error) {
+	res, err := r.q.GetWebhook(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ListWebhooks(ctx context.Context, ownerID uuid.NullUUID) ([]query.Webhook, error) {
+	res, err := r.q.ListWebhooks(ctx, ownerID)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) UpdateWebhook(ctx context.Context, arg query.UpdateWebhookParams) (query.Webhook, error) {
+	res, err := r.q.UpdateWebhook(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) DeleteWebhook(ctx context.Context, arg query.DeleteWebhookParams) error {
+	affected, err := r.q.DeleteWebhook(ctx, arg)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) ListWebhookDeliveries(
+	ctx context.Context, arg query.ListWebhookDeliveriesParams,
+) ([]query.WebhookDelivery, error) {
+	res, err := r.q.ListWebhookDeliveries(ctx, arg)
+
+	return res, storageError(err)
+}
+
+func (r *Repo) ListWebhookAttempts(ctx context.Context, deliveryIDs []int64) ([]query.WebhookAttempt, error) {
+	res, err := r.q.ListWebhookAttempts(ctx, deliveryIDs)
+
+	return res, storageError(err)
+}
+
+// RedeliverWebhookDelivery возвращает ErrNotDead, если доставка есть, но не в состоянии dead.
+func (r *Repo) RedeliverWebhookDelivery(
+	ctx context.Context, arg query.RedeliverWebhookDeliveryParams,
+) (query.WebhookDelivery, error) {
+	res, err := r.q.RedeliverWebhookDelivery(ctx, arg)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return res, storageError(err)
+	}
+
+	_, err = r.q.GetWebhookDelivery(ctx, query.GetWebhookDeliveryParams(arg))
+	if errors.Is(err, sql.ErrNoRows) {
+		return res, ErrDeliveryNotFound
+	}
+
+	if err != nil {
+		return res, storageError(err)
+	}
+
+	return res, ErrNotDead
+}
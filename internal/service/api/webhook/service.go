@@ -0,0 +1,364 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"otusgruz/internal/models"
+	"otusgruz/internal/outbox"
+	query "otusgruz/internal/repo"
+	"otusgruz/internal/webhook"
+)
+
+// secretBytes длина генерируемого ключа подписи до hex кодирования.
+const secretBytes = 32
+
+// eventTypes события, на которые можно подписаться.
+var eventTypes = []string{
+	outbox.TypeUserCreated,
+	outbox.TypeUserUpdated,
+	outbox.TypeUserDeleted,
+	outbox.TypeUserRestored,
+	outbox.TypeUserPurged,
+}
+
+type repo interface {
+	InsertWebhook(ctx context.Context, arg query.InsertWebhookParams) (query.Webhook, error)
+	GetWebhook(ctx context.Context, arg query.GetWebhookParams) (query.Webhook, error)
+	ListWebhooks(ctx context.Context, ownerID uuid.NullUUID) ([]query.Webhook, error)
+	UpdateWebhook(ctx context.Context, arg query.UpdateWebhookParams) (query.Webhook, error)
+	DeleteWebhook(ctx context.Context, arg query.DeleteWebhookParams) error
+	ListWebhookDeliveries(ctx context.Context, arg query.ListWebhookDeliveriesParams) ([]query.WebhookDelivery, error)
+	ListWebhookAttempts(ctx context.Context, deliveryIDs []int64) ([]query.WebhookAttempt, error)
+	RedeliverWebhookDelivery(ctx context.Context, arg query.RedeliverWebhookDeliveryParams) (query.WebhookDelivery, error)
+}
+
+type service struct {
+	repo repo
+}
+
+// Service управляет подписками на события. owner ограничивает операции подписками одного пользователя,
+// невалидный owner (для роли admin) снимает ограничение. Чужая подписка неотличима от отсутствующей.
+type Service interface {
+	// CreateWebhook возвращает подписку вместе с ключом подписи, другие методы ключ не возвращают.
+	CreateWebhook(ctx context.Context, owner uuid.UUID, params *models.WebhookParams) (*models.Webhook, error)
+	ListWebhooks(ctx context.Context, owner uuid.NullUUID) (*models.WebhookList, error)
+	GetWebhook(ctx context.Context, id uuid.UUID, owner uuid.NullUUID) (*models.Webhook, error)
+	// UpdateWebhook заменяет ключ подписи, только если он передан.
+	UpdateWebhook(ctx context.Context, id uuid.UUID, owner uuid.NullUUID, params *models.WebhookParams) (*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID, owner uuid.NullUUID) (*models.DefaultStatusResponse, error)
+	// ListDeliveries возвращает доставки подписки от новых к старым вместе с попытками.
+	ListDeliveries(ctx context.Context, id uuid.UUID, owner uuid.NullUUID, filter DeliveryFilter) (*models.WebhookDeliveryList, error)
+	// Redeliver возвращает доставку в состоянии dead в очередь, сбрасывая счетчик попыток.
+	Redeliver(ctx context.Context, id uuid.UUID, owner uuid.NullUUID, deliveryID int64) (*models.WebhookDelivery, error)
+}
+
+// DeliveryFilter параметры постраничной выдачи доставок.
+type DeliveryFilter struct {
+	Status *string
+	Cursor string
+	Limit  int32
+}
+
+func NewService(repo repo) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) CreateWebhook(ctx context.Context, owner uuid.UUID, params *models.WebhookParams) (*models.Webhook, error) {
+	if err := validate(params); err != nil {
+		return nil, err
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		var err error
+
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := s.repo.InsertWebhook(ctx, query.InsertWebhookParams{
+		ID:         uuid.New(),
+		OwnerID:    owner,
+		Url:        params.URL,
+		EventTypes: eventTypesOf(params),
+		Secret:     secret,
+		Active:     activeOf(params),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("inserting webhook: %w", err)
+	}
+
+	res := toWebhook(row)
+	res.Secret = row.Secret
+
+	return res, nil
+}
+
+func (s *service) ListWebhooks(ctx context.Context, owner uuid.NullUUID) (*models.WebhookList, error) {
+	rows, err := s.repo.ListWebhooks(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("listing webhooks: %w", err)
+	}
+
+	res := &models.WebhookList{
+		Items: make([]*models.Webhook, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res.Items = append(res.Items, toWebhook(row))
+	}
+
+	return res, nil
+}
+
+func (s *service) GetWebhook(ctx context.Context, id uuid.UUID, owner uuid.NullUUID) (*models.Webhook, error) {
+	row, err := s.repo.GetWebhook(ctx, query.GetWebhookParams{ID: id, OwnerID: owner})
+	if err != nil {
+		return nil, fmt.Errorf("getting webhook: %w", err)
+	}
+
+	return toWebhook(row), nil
+}
+
+func (s *service) UpdateWebhook(
+	ctx context.Context, id uuid.UUID, owner uuid.NullUUID, params *models.WebhookParams,
+) (*models.Webhook, error) {
+	if err := validate(params); err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.UpdateWebhook(ctx, query.UpdateWebhookParams{
+		Url:        params.URL,
+		EventTypes: eventTypesOf(params),
+		Secret:     sql.NullString{String: params.Secret, Valid: params.Secret != ""},
+		Active:     activeOf(params),
+		ID:         id,
+		OwnerID:    owner,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating webhook: %w", err)
+	}
+
+	return toWebhook(row), nil
+}
+
+func (s *service) DeleteWebhook(ctx context.Context, id uuid.UUID, owner uuid.NullUUID) (*models.DefaultStatusResponse, error) {
+	if err := s.repo.DeleteWebhook(ctx, query.DeleteWebhookParams{ID: id, OwnerID: owner}); err != nil {
+		return nil, fmt.Errorf("deleting webhook: %w", err)
+	}
+
+	return &models.DefaultStatusResponse{Code: "01", Message: "OK"}, nil
+}
+
+func (s *service) ListDeliveries(
+	ctx context.Context, id uuid.UUID, owner uuid.NullUUID, filter DeliveryFilter,
+) (*models.WebhookDeliveryList, error) {
+	var after sql.NullInt64
+
+	if filter.Cursor != "" {
+		cursorID, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		after = sql.NullInt64{Int64: cursorID, Valid: true}
+	}
+
+	if _, err := s.repo.GetWebhook(ctx, query.GetWebhookParams{ID: id, OwnerID: owner}); err != nil {
+		return nil, fmt.Errorf("getting webhook: %w", err)
+	}
+
+	// запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	rows, err := s.repo.ListWebhookDeliveries(ctx, query.ListWebhookDeliveriesParams{
+		WebhookID: id,
+		Status:    stringArg(filter.Status),
+		CursorID:  after,
+		RowLimit:  filter.Limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing webhook deliveries: %w", err)
+	}
+
+	res := &models.WebhookDeliveryList{
+		Items: make([]*models.WebhookDelivery, 0, len(rows)),
+	}
+
+	if len(rows) > int(filter.Limit) {
+		rows = rows[:filter.Limit]
+		res.NextCursor = encodeCursor(rows[len(rows)-1].ID)
+	}
+
+	if len(rows) == 0 {
+		return res, nil
+	}
+
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	attempts, err := s.repo.ListWebhookAttempts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("listing webhook attempts: %w", err)
+	}
+
+	byDelivery := make(map[int64][]*models.WebhookAttempt, len(rows))
+	for _, attempt := range attempts {
+		byDelivery[attempt.DeliveryID] = append(byDelivery[attempt.DeliveryID], toAttempt(attempt))
+	}
+
+	for _, row := range rows {
+		res.Items = append(res.Items, toDelivery(row, byDelivery[row.ID]))
+	}
+
+	return res, nil
+}
+
+func (s *service) Redeliver(
+	ctx context.Context, id uuid.UUID, owner uuid.NullUUID, deliveryID int64,
+) (*models.WebhookDelivery, error) {
+	if _, err := s.repo.GetWebhook(ctx, query.GetWebhookParams{ID: id, OwnerID: owner}); err != nil {
+		return nil, fmt.Errorf("getting webhook: %w", err)
+	}
+
+	row, err := s.repo.RedeliverWebhookDelivery(ctx, query.RedeliverWebhookDeliveryParams{ID: deliveryID, WebhookID: id})
+	if err != nil {
+		return nil, fmt.Errorf("redelivering webhook delivery: %w", err)
+	}
+
+	attempts, err := s.repo.ListWebhookAttempts(ctx, []int64{row.ID})
+	if err != nil {
+		return nil, fmt.Errorf("listing webhook attempts: %w", err)
+	}
+
+	history := make([]*models.WebhookAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		history = append(history, toAttempt(attempt))
+	}
+
+	return toDelivery(row, history), nil
+}
+
+// validate проверяет то, что не выражено в спецификации: схему адреса и известные типы событий.
+func validate(params *models.WebhookParams) error {
+	target, err := url.Parse(params.URL)
+	if err != nil {
+		return fmt.Errorf("%w: url: %w", ErrValidation, err)
+	}
+
+	if (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) address", ErrValidation)
+	}
+
+	for _, eventType := range params.EventTypes {
+		if !slices.Contains(eventTypes, eventType) {
+			return fmt.Errorf("%w: unknown event type %q", ErrValidation, eventType)
+		}
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating secret: %w", err)
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+// eventTypesOf возвращает фильтр без повторов, пустой фильтр означает подписку на все события.
+func eventTypesOf(params *models.WebhookParams) []string {
+	res := slices.Clone(params.EventTypes)
+	slices.Sort(res)
+
+	if res == nil {
+		return []string{}
+	}
+
+	return slices.Compact(res)
+}
+
+func activeOf(params *models.WebhookParams) bool {
+	return params.Active == nil || *params.Active
+}
+
+func toWebhook(row query.Webhook) *models.Webhook {
+	return &models.Webhook{
+		ID:         strfmt.UUID(row.ID.String()),
+		OwnerID:    strfmt.UUID(row.OwnerID.String()),
+		URL:        row.Url,
+		EventTypes: row.EventTypes,
+		Active:     row.Active,
+		CreatedAt:  strfmt.DateTime(row.CreatedAt),
+		UpdatedAt:  strfmt.DateTime(row.UpdatedAt),
+	}
+}
+
+func toDelivery(row query.WebhookDelivery, attempts []*models.WebhookAttempt) *models.WebhookDelivery {
+	res := &models.WebhookDelivery{
+		ID:           row.ID,
+		EventID:      strfmt.UUID(row.EventID.String()),
+		EventType:    row.EventType,
+		Status:       row.Status,
+		AttemptCount: row.Attempts,
+		LastError:    row.LastError.String,
+		CreatedAt:    strfmt.DateTime(row.CreatedAt),
+		DeliveredAt:  nullDateTime(row.DeliveredAt),
+		Attempts:     attempts,
+	}
+
+	if res.Attempts == nil {
+		res.Attempts = []*models.WebhookAttempt{}
+	}
+
+	if row.Status == webhook.StatusPending {
+		next := strfmt.DateTime(row.NextAttemptAt)
+		res.NextAttemptAt = &next
+	}
+
+	return res
+}
+
+func toAttempt(row query.WebhookAttempt) *models.WebhookAttempt {
+	res := &models.WebhookAttempt{
+		Error:       row.Error.String,
+		DurationMs:  row.DurationMs,
+		AttemptedAt: strfmt.DateTime(row.AttemptedAt),
+	}
+
+	if row.StatusCode.Valid {
+		res.StatusCode = &row.StatusCode.Int32
+	}
+
+	return res
+}
+
+func nullDateTime(v sql.NullTime) *strfmt.DateTime {
+	if !v.Valid {
+		return nil
+	}
+
+	res := strfmt.DateTime(v.Time)
+
+	return &res
+}
+
+func stringArg(v *string) sql.NullString {
+	if v == nil {
+		return sql.NullString{} //nolint:exhaustruct
+	}
+
+	return sql.NullString{String: *v, Valid: true}
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress адрес подписчика во внутренней сети.
+var ErrForbiddenAddress = errors.New("webhook address is not public")
+
+// reservedPrefixes глобальные по виду, но не публичные адреса: "эта сеть", CGNAT (через него у части облаков
+// доступны служебные сервисы) и NAT64, через который можно обратиться к внутреннему IPv4 адресу.
+var reservedPrefixes = []netip.Prefix{ //nolint:gochecknoglobals
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// NewClient HTTP клиент доставок. Без allowPrivate соединения с loopback, link-local, частными
+// и служебными адресами запрещены: адрес проверяется при установке каждого соединения, уже после
+// разрешения имени, поэтому ни имя с внутренним адресом, ни редирект во внутреннюю сеть проверку не обходят.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{ //nolint:exhaustruct
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second, //nolint:mnd
+	}
+
+	if !allowPrivate {
+		dialer.Control = publicOnly
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.DialContext = dialer.DialContext
+	// через прокси проверялся бы адрес прокси, а не подписчика
+	transport.Proxy = nil
+
+	return &http.Client{Timeout: timeout, Transport: transport} //nolint:exhaustruct
+}
+
+// publicOnly Control для net.Dialer, address - уже разрешенный IP адрес с портом.
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+
+	if ip := addrPort.Addr().Unmap(); !isPublic(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+	}
+
+	return nil
+}
+
+// isPublic IsGlobalUnicast уже исключает loopback, link-local, multicast и unspecified адреса.
+func isPublic(ip netip.Addr) bool {
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
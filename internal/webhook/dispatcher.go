@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	query "otusgruz/internal/repo"
+)
+
+// Состояния доставки.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusDead      = "dead"
+)
+
+var ErrUnexpectedStatus = errors.New("unexpected response status")
+
+// leaseMargin запас сверх таймаута запроса, на который доставка скрывается от других диспетчеров.
+// Если диспетчер упал, не записав результат, доставка снова станет доступной по истечении аренды.
+const leaseMargin = time.Minute
+
+// maxResponseBody сколько байт ответа вычитывается, чтобы соединение можно было переиспользовать.
+const maxResponseBody = 64 << 10
+
+type repo interface {
+	ClaimWebhookDeliveries(ctx context.Context, arg query.ClaimWebhookDeliveriesParams) ([]query.ClaimWebhookDeliveriesRow, error)
+	InsertWebhookAttempt(ctx context.Context, arg query.InsertWebhookAttemptParams) error
+	UpdateWebhookDelivery(ctx context.Context, arg query.UpdateWebhookDeliveryParams) error
+	InTx(ctx context.Context, fn func(tx repo) error) error
+}
+
+type Options struct {
+	BatchSize    int32
+	PollInterval time.Duration
+	// Timeout ограничение на один HTTP запрос.
+	Timeout time.Duration
+	// MaxAttempts после стольких неудачных попыток доставка переходит в состояние dead.
+	MaxAttempts int32
+	// BackoffBase пауза после первой неудачи, каждая следующая вдвое длиннее, но не больше BackoffMax.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// Envelope тело запроса доставки.
+type Envelope struct {
+	ID   uuid.UUID       `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Dispatcher отправляет доставки, поставленные в очередь вместе с событием outbox.
+type Dispatcher struct {
+	repo   repo
+	client *http.Client
+	opts   Options
+}
+
+func NewDispatcher(repo repo, client *http.Client, opts Options) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: client,
+		opts:   opts,
+	}
+}
+
+// Run отправляет доставки до отмены ctx. После неполной пачки или ошибки ждет PollInterval.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		claimed, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			zerolog.Ctx(ctx).Err(err).Msg("dispatch webhook deliveries")
+		}
+
+		if err == nil && claimed == int(d.opts.BatchSize) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(d.opts.PollInterval):
+		}
+	}
+}
+
+// DispatchOnce забирает одну пачку готовых к отправке доставок, отправляет их параллельно
+// и возвращает размер пачки.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	rows, err := d.repo.ClaimWebhookDeliveries(ctx, query.ClaimWebhookDeliveriesParams{
+		LeaseUntil: time.Now().Add(d.opts.Timeout + leaseMargin),
+		BatchSize:  d.opts.BatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for _, row := range rows {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := d.deliver(ctx, row); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("delivery %d: %w", row.ID, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return len(rows), errors.Join(errs...)
+}
+
+// deliver выполняет одну попытку и записывает ее результат. Попытка, прерванная остановкой диспетчера,
+// не записывается: доставка повторится после истечения аренды.
+func (d *Dispatcher) deliver(ctx context.Context, row query.ClaimWebhookDeliveriesRow) error {
+	started := time.Now()
+	statusCode, sendErr := d.send(ctx, row)
+	finished := time.Now()
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	attempt := query.InsertWebhookAttemptParams{
+		DeliveryID: row.ID,
+		StatusCode: sql.NullInt32{Int32: int32(statusCode), Valid: statusCode != 0}, //nolint:gosec
+		Error:      sql.NullString{},                                                //nolint:exhaustruct
+		DurationMs: finished.Sub(started).Milliseconds(),
+	}
+
+	update := query.UpdateWebhookDeliveryParams{
+		Status:        StatusDelivered,
+		Attempts:      row.Attempts + 1,
+		NextAttemptAt: finished,
+		LastError:     sql.NullString{}, //nolint:exhaustruct
+		DeliveredAt:   sql.NullTime{Time: finished, Valid: true},
+		ID:            row.ID,
+	}
+
+	if sendErr != nil {
+		zerolog.Ctx(ctx).Warn().Err(sendErr).Int64("delivery", row.ID).Msg("webhook delivery attempt failed")
+
+		attempt.Error = sql.NullString{String: attemptError(sendErr), Valid: true}
+		update.LastError = attempt.Error
+		update.DeliveredAt = sql.NullTime{} //nolint:exhaustruct
+		update.Status = StatusPending
+		update.NextAttemptAt = finished.Add(Backoff(update.Attempts, d.opts.BackoffBase, d.opts.BackoffMax))
+
+		if update.Attempts >= d.opts.MaxAttempts {
+			update.Status = StatusDead
+		}
+	}
+
+	return d.repo.InTx(ctx, func(tx repo) error {
+		if err := tx.InsertWebhookAttempt(ctx, attempt); err != nil {
+			return fmt.Errorf("insert webhook attempt: %w", err)
+		}
+
+		if err := tx.UpdateWebhookDelivery(ctx, update); err != nil {
+			return fmt.Errorf("update webhook delivery: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// send отправляет событие и возвращает HTTP статус ответа, 0 если ответ не получен.
+// Успешными считаются только ответы 2xx.
+func (d *Dispatcher) send(ctx context.Context, row query.ClaimWebhookDeliveriesRow) (int, error) {
+	body, err := json.Marshal(Envelope{ID: row.EventID, Type: row.EventType, Data: row.Payload})
+	if err != nil {
+		return 0, fmt.Errorf("marshal event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, row.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, row.EventID.String())
+	req.Header.Set(HeaderEvent, row.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(row.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// attemptError текст ошибки попытки для истории доставок, которую видит владелец подписки. Причина отказа
+// соединения и адреса остаются только в логе, иначе по истории можно было бы сканировать сеть сервиса.
+func attemptError(err error) string {
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, ErrUnexpectedStatus):
+		return err.Error()
+	case errors.Is(err, ErrForbiddenAddress):
+		return ErrForbiddenAddress.Error()
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "request timed out"
+	default:
+		return "request failed"
+	}
+}
+
+// Backoff пауза перед следующей попыткой после attempts неудачных: base * 2^(attempts-1), не больше limit.
+func Backoff(attempts int32, base, limit time.Duration) time.Duration {
+	delay := base
+
+	for i := int32(1); i < attempts && delay < limit; i++ {
+		delay *= 2
+	}
+
+	return min(delay, limit)
+}
@@ -0,0 +1,374 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	query "otusgruz/internal/repo"
+)
+
+const testSecret = "subscriber-secret"
+
+// delivery строка webhook_deliveries в memRepo.
+type delivery struct {
+	row           query.ClaimWebhookDeliveriesRow
+	status        string
+	nextAttemptAt time.Time
+	lastError     sql.NullString
+	deliveredAt   sql.NullTime
+}
+
+// memRepo очередь доставок в памяти с той же логикой выборки, что у ClaimWebhookDeliveries.
+type memRepo struct {
+	mu         sync.Mutex
+	deliveries map[int64]*delivery
+	attempts   []query.InsertWebhookAttemptParams
+}
+
+func newMemRepo(url string) *memRepo {
+	return &memRepo{ //nolint:exhaustruct
+		deliveries: map[int64]*delivery{
+			1: {
+				row: query.ClaimWebhookDeliveriesRow{
+					ID:        1,
+					WebhookID: uuid.New(),
+					EventID:   uuid.New(),
+					EventType: "user.created",
+					Payload:   json.RawMessage(`{"guid":"6f1c7d2e-8a45-4f7b-9c1e-2d3a4b5c6d7e","name":"Alice"}`),
+					Url:       url,
+					Secret:    testSecret,
+				},
+				status:        StatusPending,
+				nextAttemptAt: time.Now(),
+			},
+		},
+	}
+}
+
+func (m *memRepo) InTx(_ context.Context, fn func(tx repo) error) error {
+	return fn(m)
+}
+
+func (m *memRepo) ClaimWebhookDeliveries(
+	_ context.Context, arg query.ClaimWebhookDeliveriesParams,
+) ([]query.ClaimWebhookDeliveriesRow, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var res []query.ClaimWebhookDeliveriesRow
+
+	for _, d := range m.deliveries {
+		if d.status == StatusPending && !d.nextAttemptAt.After(time.Now()) && len(res) < int(arg.BatchSize) {
+			d.nextAttemptAt = arg.LeaseUntil
+			res = append(res, d.row)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memRepo) InsertWebhookAttempt(_ context.Context, arg query.InsertWebhookAttemptParams) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.attempts = append(m.attempts, arg)
+
+	return nil
+}
+
+func (m *memRepo) UpdateWebhookDelivery(_ context.Context, arg query.UpdateWebhookDeliveryParams) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	d := m.deliveries[arg.ID]
+	d.status, d.row.Attempts, d.nextAttemptAt = arg.Status, arg.Attempts, arg.NextAttemptAt
+	d.lastError, d.deliveredAt = arg.LastError, arg.DeliveredAt
+
+	return nil
+}
+
+// due делает доставку готовой к отправке, не дожидаясь паузы перед повтором.
+func (m *memRepo) due(id int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deliveries[id].nextAttemptAt = time.Now()
+}
+
+// redeliver то же, что RedeliverWebhookDelivery.
+func (m *memRepo) redeliver(id int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	d := m.deliveries[id]
+	if d.status == StatusDead {
+		d.status, d.row.Attempts, d.nextAttemptAt, d.lastError = StatusPending, 0, time.Now(), sql.NullString{} //nolint:exhaustruct
+	}
+}
+
+func (m *memRepo) delivery(id int64) delivery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return *m.deliveries[id]
+}
+
+var testOptions = Options{ //nolint:gochecknoglobals
+	BatchSize:    10,
+	PollInterval: time.Second,
+	Timeout:      time.Second,
+	MaxAttempts:  3,
+	BackoffBase:  time.Minute,
+	BackoffMax:   time.Hour,
+}
+
+// subscriber отвечает статусами из statuses по очереди, последний повторяется.
+func subscriber(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(statuses[min(int(calls.Add(1)), len(statuses))-1])
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func dispatch(t *testing.T, d *Dispatcher, want int) {
+	t.Helper()
+
+	claimed, err := d.DispatchOnce(context.Background())
+	if err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+
+	if claimed != want {
+		t.Fatalf("claimed %d deliveries, want %d", claimed, want)
+	}
+}
+
+func TestDispatcherSignsRequests(t *testing.T) {
+	var (
+		got    *http.Request
+		body   []byte
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	)
+	defer server.Close()
+
+	repo := newMemRepo(server.URL)
+	dispatch(t, NewDispatcher(repo, server.Client(), testOptions), 1)
+
+	row := repo.deliveries[1].row
+
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("%s header: %v", HeaderTimestamp, err)
+	}
+
+	signature := got.Header.Get(HeaderSignature)
+	if !Verify(testSecret, timestamp, body, signature) {
+		t.Errorf("signature %q does not verify", signature)
+	}
+
+	if Verify("another-secret", timestamp, body, signature) || Verify(testSecret, timestamp+1, body, signature) {
+		t.Error("signature verifies with another secret or timestamp")
+	}
+
+	if got.Header.Get(HeaderID) != row.EventID.String() || got.Header.Get(HeaderEvent) != row.EventType {
+		t.Errorf("headers %s=%q %s=%q, want event %s %s",
+			HeaderID, got.Header.Get(HeaderID), HeaderEvent, got.Header.Get(HeaderEvent), row.EventID, row.EventType)
+	}
+
+	var envelope Envelope
+	if err = json.Unmarshal(body, &envelope); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+
+	if envelope.ID != row.EventID || envelope.Type != row.EventType || string(envelope.Data) != string(row.Payload) {
+		t.Errorf("envelope %+v, want event %s %s %s", envelope, row.EventID, row.EventType, row.Payload)
+	}
+
+	res := repo.delivery(1)
+	if res.status != StatusDelivered || !res.deliveredAt.Valid || res.row.Attempts != 1 {
+		t.Errorf("delivery %s after %d attempts, delivered at %v", res.status, res.row.Attempts, res.deliveredAt)
+	}
+
+	if len(repo.attempts) != 1 || repo.attempts[0].StatusCode.Int32 != http.StatusNoContent {
+		t.Errorf("attempts %+v, want one with status 204", repo.attempts)
+	}
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	server, calls := subscriber(t, http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK)
+	repo := newMemRepo(server.URL)
+	d := NewDispatcher(repo, server.Client(), testOptions)
+
+	for attempt, wantErr := range []string{"unexpected response status: 500", "unexpected response status: 502"} {
+		before := time.Now()
+
+		dispatch(t, d, 1)
+
+		res := repo.delivery(1)
+		if res.status != StatusPending || res.lastError.String != wantErr {
+			t.Fatalf("attempt %d: delivery %s with error %q, want pending with %q", attempt+1, res.status, res.lastError.String, wantErr)
+		}
+
+		backoff := Backoff(int32(attempt+1), testOptions.BackoffBase, testOptions.BackoffMax) //nolint:gosec
+		if wait := res.nextAttemptAt.Sub(before); wait < backoff || wait > backoff+time.Second {
+			t.Errorf("attempt %d: next attempt in %v, want %v", attempt+1, wait, backoff)
+		}
+
+		// до истечения паузы доставка не отправляется повторно
+		dispatch(t, d, 0)
+		repo.due(1)
+	}
+
+	dispatch(t, d, 1)
+
+	if res := repo.delivery(1); res.status != StatusDelivered || res.row.Attempts != 3 || res.lastError.Valid {
+		t.Errorf("delivery %s after %d attempts with error %q, want delivered after 3", res.status, res.row.Attempts, res.lastError.String)
+	}
+
+	if calls.Load() != 3 || len(repo.attempts) != 3 {
+		t.Errorf("%d requests and %d recorded attempts, want 3", calls.Load(), len(repo.attempts))
+	}
+}
+
+func TestDispatcherDeadAndRedeliver(t *testing.T) {
+	var healthy atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	repo := newMemRepo(server.URL)
+	d := NewDispatcher(repo, server.Client(), testOptions)
+
+	for range testOptions.MaxAttempts {
+		dispatch(t, d, 1)
+		repo.due(1)
+	}
+
+	if res := repo.delivery(1); res.status != StatusDead || res.row.Attempts != testOptions.MaxAttempts {
+		t.Fatalf("delivery %s after %d attempts, want dead after %d", res.status, res.row.Attempts, testOptions.MaxAttempts)
+	}
+
+	// доставка в состоянии dead больше не отправляется
+	dispatch(t, d, 0)
+
+	healthy.Store(true)
+	repo.redeliver(1)
+	dispatch(t, d, 1)
+
+	if res := repo.delivery(1); res.status != StatusDelivered || res.row.Attempts != 1 {
+		t.Errorf("redelivered %s after %d attempts, want delivered after 1", res.status, res.row.Attempts)
+	}
+}
+
+func TestDispatcherHidesConnectionDetails(t *testing.T) {
+	server, calls := subscriber(t, http.StatusOK)
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		want         string
+	}{
+		{name: "private address", url: server.URL, allowPrivate: false, want: ErrForbiddenAddress.Error()},
+		{name: "connection refused", url: closed.URL, allowPrivate: true, want: "request failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemRepo(tt.url)
+			dispatch(t, NewDispatcher(repo, NewClient(time.Second, tt.allowPrivate), testOptions), 1)
+
+			if res := repo.delivery(1); res.lastError.String != tt.want {
+				t.Errorf("last error %q, want %q", res.lastError.String, tt.want)
+			}
+
+			if attempt := repo.attempts[0]; attempt.Error.String != tt.want || attempt.StatusCode.Valid {
+				t.Errorf("attempt error %q with status %v, want %q without status", attempt.Error.String, attempt.StatusCode, tt.want)
+			}
+		})
+	}
+
+	if calls.Load() != 0 {
+		t.Errorf("subscriber on a private address received %d requests", calls.Load())
+	}
+}
+
+func TestPublicOnly(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8:443":                 true,
+		"[2606:4700:4700::1111]:443":  true,
+		"127.0.0.1:80":                false,
+		"[::1]:80":                    false,
+		"10.12.0.5:5432":              false,
+		"172.16.0.1:80":               false,
+		"192.168.1.1:80":              false,
+		"169.254.169.254:80":          false,
+		"[fe80::1]:80":                false,
+		"[fd00::1]:80":                false,
+		"[::ffff:10.0.0.1]:80":        false,
+		"100.100.100.200:80":          false,
+		"0.0.0.0:80":                  false,
+		"[64:ff9b::a00:1]:80":         false,
+		"metadata.google.internal:80": false,
+	}
+
+	for address, want := range tests {
+		err := publicOnly("tcp", address, nil)
+
+		if got := err == nil; got != want {
+			t.Errorf("%s: allowed %v, want %v", address, got, want)
+		}
+
+		if err != nil && !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s: error %v, want %v", address, err, ErrForbiddenAddress)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 4, want: 8 * time.Minute},
+		{attempts: 7, want: time.Hour},
+		{attempts: 100, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts, time.Minute, time.Hour); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	query "otusgruz/internal/repo"
+)
+
+type Repo struct {
+	db *sql.DB
+	tx *sql.Tx
+	q  *query.Queries
+}
+
+func NewRepo(db *sql.DB, q *query.Queries) *Repo {
+	return &Repo{db: db, q: q} //nolint:exhaustruct
+}
+
+// InTx выполняет fn в транзакции. Вложенный вызов переиспользует уже открытую транзакцию.
+func (r *Repo) InTx(ctx context.Context, fn func(tx repo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	if err = fn(&Repo{db: r.db, tx: tx, q: r.q.WithTx(tx)}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) ClaimWebhookDeliveries(
+	ctx context.Context, arg query.ClaimWebhookDeliveriesParams,
+) ([]query.ClaimWebhookDeliveriesRow, error) {
+	return r.q.ClaimWebhookDeliveries(ctx, arg) //nolint:wrapcheck
+}
+
+func (r *Repo) InsertWebhookAttempt(ctx context.Context, arg query.InsertWebhookAttemptParams) error {
+	return r.q.InsertWebhookAttempt(ctx, arg) //nolint:wrapcheck
+}
+
+func (r *Repo) UpdateWebhookDelivery(ctx context.Context, arg query.UpdateWebhookDeliveryParams) error {
+	return r.q.UpdateWebhookDelivery(ctx, arg) //nolint:wrapcheck
+}
@@ -16,10 +16,10 @@ func main() {
 		panic(err)
 	}
 
-	ctx := context.Background()
-
 	logger := zerolog.New(os.Stdout).With().Timestamp().Caller().Logger()
 
+	ctx := logger.WithContext(context.Background())
+
 	exitCode := 0
 
 	logger.Info().Msg("application is launching")